@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"os"
+	"sync"
+	"time"
+
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// Classes of received envelopes, depending on what let them through.
+// Whisper rejects envelopes not even matching our bloom filter before they
+// reach the pool, so those are only counted by its metrics.
+const (
+	bloomMatched   = "matched"        // topic of an installed filter
+	bloomFalseHit  = "bloom-match"    // not ours, but matching our bloom filter
+	bloomUnmatched = "no-bloom-match" // not even matching our bloom filter, rejected
+)
+
+// bloomRefresh is how often the topics of the installed filters are loaded.
+const bloomRefresh = 1 * time.Second
+
+// bloomStats sorts the envelopes received from peers by whether an exact
+// topic filter would have let them through, to tell how many bytes the bloom
+// filter approximation costs.
+type bloomStats struct {
+	sync.Mutex
+	node *Bstatus
+
+	topics    map[whisper.TopicType]bool
+	refreshed time.Time
+
+	traffic map[string]topicTraffic
+}
+
+func newBloomStats(node *Bstatus) *bloomStats {
+	s := &bloomStats{
+		node:    node,
+		traffic: make(map[string]topicTraffic),
+	}
+	node.wire.OnReceived(s.Received)
+	return s
+}
+
+// installed returns the topics of the filters currently installed.
+func (s *bloomStats) installed() map[whisper.TopicType]bool {
+	if time.Since(s.refreshed) < bloomRefresh {
+		return s.topics
+	}
+
+	s.topics = make(map[whisper.TopicType]bool)
+	for _, filter := range s.node.messenger.Filters() {
+		s.topics[filter.Topic] = true
+	}
+	s.refreshed = time.Now()
+	return s.topics
+}
+
+// Received records an envelope received from a peer.
+func (s *bloomStats) Received(envelope *whisper.Envelope) {
+	s.Lock()
+	defer s.Unlock()
+
+	class := bloomFalseHit
+	if s.installed()[envelope.Topic] {
+		class = bloomMatched
+	}
+
+	traffic := s.traffic[class]
+	traffic.envelopes++
+	traffic.bytes += envelopeSize(envelope)
+	s.traffic[class] = traffic
+}
+
+// Save writes the traffic of each class, the bytes an exact topic filter
+// would have saved, and the expected false positive rate of the bloom
+// filter advertised at the end of the run. The size of rejected envelopes
+// isn't known, so they are left out of the bytes.
+func (s *bloomStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	total := 0
+	for _, class := range []string{bloomMatched, bloomFalseHit} {
+		traffic := s.traffic[class]
+		fmt.Fprintf(f, "%s: envelopes: %d, bytes: %d\n", class, traffic.envelopes, traffic.bytes)
+		total += traffic.bytes
+	}
+	fmt.Fprintf(f, "%s: envelopes: %d\n", bloomUnmatched, whisperCounter("envelopeErrNoBloomMatch"))
+
+	wasted := total - s.traffic[bloomMatched].bytes
+	share := 0.0
+	if total != 0 {
+		share = float64(wasted) / float64(total)
+	}
+	fmt.Fprintf(f, "wasted-bytes: %d, exact-filter-bytes: %d, wasted-share: %.3f\n", wasted, s.traffic[bloomMatched].bytes, share)
+
+	// A full node has no bloom filter, every envelope matches it
+	bloom := s.node.shh.BloomFilter()
+	if bloom == nil {
+		fmt.Fprintf(f, "light-client: false\n")
+		return nil
+	}
+	set := 0
+	for _, b := range bloom {
+		set += bits.OnesCount8(b)
+	}
+	// Every topic sets 3 bits of the filter
+	rate := math.Pow(float64(set)/float64(whisper.BloomFilterSize*8), 3)
+	fmt.Fprintf(f, "light-client: true, topics: %d, bloom-bits-set: %d, false-positive-rate: %.5f\n", len(s.installed()), set, rate)
+	return nil
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	transport "github.com/status-im/status-protocol-go/transport/whisper"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+func churnChatName(n int) string {
+	return fmt.Sprintf("churn-%d", n)
+}
+
+func chatTopic(name string) whisper.TopicType {
+	return whisper.BytesToTopic(transport.ToTopic(name))
+}
+
+// chatChurn joins and leaves public chats on a schedule, and measures what
+// it costs: the filters installed and removed, the bloom filter updates
+// sent to peers, and the traffic still received for chats we left.
+type chatChurn struct {
+	sync.Mutex
+	node      *Bstatus
+	chats     int           // size of the pool of public chats
+	maxJoined int           // how many chats are joined at most at any time
+	interval  time.Duration // time between two join or leave operations
+	done      chan bool
+
+	joined map[string]bool
+	left   map[string]topicTraffic // received on the topic when we left it
+
+	log *os.File
+
+	joins, leaves       int
+	joinTime, leaveTime time.Duration
+	bloomUpdates        int
+	bloomEstimated      int // bytes of the updates, rlp size times peers
+	irrelevant          topicTraffic
+}
+
+func newChatChurn(node *Bstatus, chats, maxJoined int, interval time.Duration) (*chatChurn, error) {
+	log, err := os.Create(node.sourceDir + "churn-log.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	return &chatChurn{
+		node:      node,
+		chats:     chats,
+		maxJoined: maxJoined,
+		interval:  interval,
+		done:      make(chan bool),
+		joined:    make(map[string]bool),
+		left:      make(map[string]topicTraffic),
+		log:       log,
+	}, nil
+}
+
+func (c *chatChurn) Start() {
+	go c.loop()
+}
+
+func (c *chatChurn) Stop() {
+	close(c.done)
+}
+
+func (c *chatChurn) loop() {
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := c.step(); err != nil {
+				fmt.Printf("Error churning chats: %+v", err)
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// step joins a random chat, or leaves one once maxJoined has been reached.
+// Chats are picked under the lock, and joined or left outside of it, so
+// RandomChat isn't held up by the network. Only the loop calls step.
+func (c *chatChurn) step() error {
+	c.Lock()
+	join := len(c.joined) == 0 || (len(c.joined) < c.maxJoined && rand.Intn(2) == 0)
+	var candidates []string
+	for i := 0; i < c.chats; i++ {
+		name := churnChatName(i)
+		if c.joined[name] != join {
+			candidates = append(candidates, name)
+		}
+	}
+	c.Unlock()
+	if len(candidates) == 0 {
+		return nil
+	}
+	name := candidates[rand.Intn(len(candidates))]
+
+	bloom := c.node.shh.BloomFilter()
+	start := time.Now()
+
+	action := "leave"
+	if join {
+		action = "join"
+		if err := c.node.JoinChannel(name); err != nil {
+			return err
+		}
+	} else {
+		if err := c.node.LeaveChannel(name); err != nil {
+			return err
+		}
+	}
+	elapsed := time.Since(start)
+
+	// Every peer is sent the new bloom filter with a bloomFilterExCode
+	// message, which the wire tap doesn't see, so its bytes are estimated
+	peers, estimated := 0, 0
+	newBloom := c.node.shh.BloomFilter()
+	updated := !bytes.Equal(bloom, newBloom)
+	if updated {
+		peers = c.node.statusNode.PeerCount()
+		payload, err := rlp.EncodeToBytes(newBloom)
+		if err != nil {
+			return err
+		}
+		estimated = peers * len(payload)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if join {
+		c.joinTime += elapsed
+		c.joins++
+		c.joined[name] = true
+
+		// Stop counting traffic for the chat, it is relevant again
+		if traffic, ok := c.left[name]; ok {
+			c.addIrrelevant(name, traffic)
+			delete(c.left, name)
+		}
+	} else {
+		c.leaveTime += elapsed
+		c.leaves++
+		delete(c.joined, name)
+		c.left[name] = c.node.wire.Received(chatTopic(name))
+	}
+	if updated {
+		c.bloomUpdates++
+		c.bloomEstimated += estimated
+	}
+
+	c.log.WriteString(fmt.Sprintf("%d %s %s %d %d\n", start.UnixNano()/int64(time.Millisecond), action, name, elapsed.Nanoseconds()/int64(time.Microsecond), peers))
+	return nil
+}
+
+func (c *chatChurn) addIrrelevant(name string, since topicTraffic) {
+	traffic := c.node.wire.Received(chatTopic(name))
+	c.irrelevant.envelopes += traffic.envelopes - since.envelopes
+	c.irrelevant.bytes += traffic.bytes - since.bytes
+}
+
+// RandomChat returns one of the joined chats, or an empty string if there
+// are none.
+func (c *chatChurn) RandomChat() string {
+	c.Lock()
+	defer c.Unlock()
+
+	var names []string
+	for name := range c.joined {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return names[rand.Intn(len(names))]
+}
+
+func (c *chatChurn) Save(path string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	for name, traffic := range c.left {
+		c.addIrrelevant(name, traffic)
+		c.left[name] = c.node.wire.Received(chatTopic(name))
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintf(f, "joins: %d, average-join-time: %s\n", c.joins, averageDuration(c.joinTime, c.joins))
+	fmt.Fprintf(f, "leaves: %d, average-leave-time: %s\n", c.leaves, averageDuration(c.leaveTime, c.leaves))
+	fmt.Fprintf(f, "bloom-updates: %d, bloom-bytes-estimated: %d\n", c.bloomUpdates, c.bloomEstimated)
+	fmt.Fprintf(f, "irrelevant-envelopes: %d, irrelevant-bytes: %d\n", c.irrelevant.envelopes, c.irrelevant.bytes)
+	return nil
+}
+
+func averageDuration(total time.Duration, n int) time.Duration {
+	if n == 0 {
+		return 0
+	}
+	return total / time.Duration(n)
+}
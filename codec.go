@@ -0,0 +1,318 @@
+package main
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/status-im/status-protocol-go/applicationmetadata"
+	"github.com/status-im/status-protocol-go/encryption"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// Contents of the messages sent by the send loop.
+const (
+	contentTest   = "test"   // the same short word, as the loop always sent
+	contentText   = "text"   // chat-like sentences of common words
+	contentRandom = "random" // random printable bytes, barely compressible
+)
+
+// contentWords are common chat words, which sentences are made of and which
+// the shared dictionary of deflate is built from.
+var contentWords = strings.Fields(`the to and you that it is of for in what have
+	this are on with just not but so be can do was your all if at we like my get
+	me no know ok yes will about going there think one how good time now
+	see out they when up then here from would really today tomorrow meeting send
+	message chat thanks lol haha sure right where want need let call back`)
+
+// contentPayload returns the payload of a message of the given content.
+func contentPayload(content string) []byte {
+	switch content {
+	case contentText:
+		var words []string
+		for i, n := 0, 3+rand.Intn(30); i < n; i++ {
+			words = append(words, contentWords[rand.Intn(len(contentWords))])
+		}
+		return []byte(strings.Join(words, " "))
+	case contentRandom:
+		return tracePayload(10 + rand.Intn(200))
+	default:
+		return []byte("test")
+	}
+}
+
+// payloadCodec compresses application payloads before they are encrypted.
+// The zero codec leaves them as they are.
+type payloadCodec struct {
+	compress   func([]byte) ([]byte, error)
+	decompress func([]byte) ([]byte, error)
+}
+
+var deflateDictionary = []byte(strings.Join(contentWords, " "))
+
+// payloadCodecs are the codecs of the codec stage. zstd isn't vendored.
+var payloadCodecs = map[string]payloadCodec{
+	"none": {},
+	"gzip": {
+		compress: func(payload []byte) ([]byte, error) {
+			var buf bytes.Buffer
+			w := gzip.NewWriter(&buf)
+			if _, err := w.Write(payload); err != nil {
+				return nil, err
+			}
+			err := w.Close()
+			return buf.Bytes(), err
+		},
+		decompress: func(data []byte) ([]byte, error) {
+			r, err := gzip.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			return ioutil.ReadAll(r)
+		},
+	},
+	"snappy": {
+		compress: func(payload []byte) ([]byte, error) {
+			return snappy.Encode(nil, payload), nil
+		},
+		decompress: func(data []byte) ([]byte, error) {
+			return snappy.Decode(nil, data)
+		},
+	},
+	"deflate-dict": {
+		compress: func(payload []byte) ([]byte, error) {
+			var buf bytes.Buffer
+			w, err := flate.NewWriterDict(&buf, flate.BestCompression, deflateDictionary)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := w.Write(payload); err != nil {
+				return nil, err
+			}
+			err = w.Close()
+			return buf.Bytes(), err
+		},
+		decompress: func(data []byte) ([]byte, error) {
+			return ioutil.ReadAll(flate.NewReaderDict(bytes.NewReader(data), deflateDictionary))
+		},
+	},
+}
+
+// pendingRecordExpiry is how long the uncompressed size of a record is kept
+// for its envelope. The envelopes of direct messages can't be opened, so
+// their records are dropped once expired.
+const pendingRecordExpiry = time.Minute
+
+// pendingRecord is the uncompressed size of a record sent, until its
+// envelope is seen.
+type pendingRecord struct {
+	size    int
+	encoded time.Time
+}
+
+// codecStats runs the codec stage of a node, and measures the bytes it
+// saves and what it costs, in wall time. The codec compresses the application layer of
+// every message, which is carried as is, before it is wrapped and
+// encrypted. The envelopes of public chats are opened to tell what the
+// compression saved once padded by Whisper.
+type codecStats struct {
+	sync.Mutex
+	node    *Bstatus
+	name    string
+	content string
+	codec   payloadCodec
+
+	sent, payloadBytes int // payloads of the messages sent
+
+	records, received, failed    int
+	recordBytes, compressed      int // application layer, before and after compression
+	compressTime, decompressTime time.Duration
+
+	// Uncompressed sizes of the records sent, by compressed record, until
+	// their envelope is seen or they expire
+	uncompressed map[string]pendingRecord
+
+	envelopes, unattributed   int
+	whisperBytes, whisperFull int // Whisper payloads, padded, as sent and uncompressed
+	wireBytes, wireFull       int // envelopes, as sent and uncompressed
+}
+
+// newCodecStats returns the codec stage of a node, nil with the none codec,
+// which leaves the application layer alone.
+func newCodecStats(node *Bstatus, name, content string) (*codecStats, error) {
+	codec, ok := payloadCodecs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown codec %q", name)
+	}
+	if content != contentTest && content != contentText && content != contentRandom {
+		return nil, fmt.Errorf("unknown payload content %q", content)
+	}
+	if codec.compress == nil {
+		return nil, nil
+	}
+	return &codecStats{
+		node:         node,
+		name:         name,
+		content:      content,
+		codec:        codec,
+		uncompressed: make(map[string]pendingRecord),
+	}, nil
+}
+
+// Start opens the envelopes posted by the node, once it is connected.
+func (s *codecStats) Start() {
+	s.node.wire.OnSent(s.Envelope)
+}
+
+// Sent records the payload of a message sent by the node.
+func (s *codecStats) Sent(payload []byte) {
+	s.Lock()
+	defer s.Unlock()
+	s.sent++
+	s.payloadBytes += len(payload)
+}
+
+// Encode compresses the application layer of a message to be sent.
+func (s *codecStats) Encode(record []byte) ([]byte, error) {
+	start := time.Now()
+	compressed, err := s.codec.compress(record)
+	elapsed := time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.records++
+	s.recordBytes += len(record)
+	s.compressed += len(compressed)
+	s.compressTime += elapsed
+	s.expire(start)
+	s.uncompressed[string(compressed)] = pendingRecord{size: len(record), encoded: start}
+	return compressed, nil
+}
+
+// Decode decompresses the application layer of a received message.
+func (s *codecStats) Decode(data []byte) ([]byte, error) {
+	start := time.Now()
+	record, err := s.codec.decompress(data)
+	elapsed := time.Since(start)
+
+	s.Lock()
+	defer s.Unlock()
+	if err != nil {
+		s.failed++
+		return nil, err
+	}
+	s.received++
+	s.decompressTime += elapsed
+	return record, nil
+}
+
+// Envelope compares the size of an envelope posted by the node with the one
+// it would have had with its record uncompressed. Only the public messages
+// we sent can be told from the opened envelope.
+func (s *codecStats) Envelope(envelope *whisper.Envelope) {
+	_, filter := s.node.topics.Classify(envelope.Topic)
+	msg := s.node.overhead.open(envelope, filter)
+
+	var protocolMessage encryption.ProtocolMessage
+	if msg != nil {
+		proto.Unmarshal(msg.Payload, &protocolMessage)
+	}
+	public := protocolMessage.GetPublicMessage()
+
+	s.Lock()
+	defer s.Unlock()
+	if len(public) == 0 {
+		s.unattributed++
+		return
+	}
+	// Records are looked up as sent first, v1 messages being wrapped
+	record := public
+	pending, ok := s.uncompressed[string(record)]
+	if !ok {
+		if wrapped, err := applicationmetadata.Unmarshal(public); err == nil {
+			record = wrapped.Payload
+			pending, ok = s.uncompressed[string(record)]
+		}
+	}
+	if !ok {
+		s.unattributed++
+		return
+	}
+	delete(s.uncompressed, string(record))
+	full := pending.size
+
+	// The public message and its wrapping grow with the record, along with
+	// the varints of their sizes
+	publicFull := len(public) + full - len(record)
+	if len(record) != len(public) {
+		publicFull += proto.SizeVarint(uint64(full)) - proto.SizeVarint(uint64(len(record)))
+	}
+	usefulFull := len(msg.Payload) + publicFull - len(public) + proto.SizeVarint(uint64(publicFull)) - proto.SizeVarint(uint64(len(public)))
+
+	sent := envelopeLayout{
+		useful:    len(msg.Payload),
+		framing:   1 + payloadSizeField(len(msg.Payload)),
+		padding:   len(msg.Padding),
+		signature: len(msg.Signature),
+		wire:      envelopeSize(envelope),
+	}
+	uncompressed := envelopeLayout{
+		useful:    usefulFull,
+		framing:   1 + payloadSizeField(usefulFull),
+		signature: sent.signature,
+	}
+	uncompressed.wire = sent.unpadded() + uncompressed.framing + uncompressed.useful - sent.framing - sent.useful
+	uncompressed.wire = uncompressed.paddedWith(whisperPadding)
+	uncompressed.padding = uncompressed.wire - uncompressed.unpadded()
+
+	s.envelopes++
+	s.whisperBytes += sent.framing + sent.useful + sent.padding + sent.signature
+	s.whisperFull += uncompressed.framing + uncompressed.useful + uncompressed.padding + uncompressed.signature
+	s.wireBytes += sent.wire
+	s.wireFull += uncompressed.wire
+}
+
+// expire drops the records whose envelope wasn't seen in time.
+func (s *codecStats) expire(now time.Time) {
+	for record, pending := range s.uncompressed {
+		if now.Sub(pending.encoded) > pendingRecordExpiry {
+			delete(s.uncompressed, record)
+		}
+	}
+}
+
+// Save writes, for every layer, the bytes sent and the bytes the codec
+// saved, and the wall time spent compressing and decompressing.
+func (s *codecStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ratio := 0.0
+	if s.recordBytes != 0 {
+		ratio = float64(s.compressed) / float64(s.recordBytes)
+	}
+	fmt.Fprintf(f, "codec: %s, content: %s, sent: %d, payload-bytes: %d\n", s.name, s.content, s.sent, s.payloadBytes)
+	fmt.Fprintf(f, "layer: application, records: %d, bytes: %d, uncompressed-bytes: %d, compression-ratio: %.3f, saved-bytes: %d\n", s.records, s.compressed, s.recordBytes, ratio, s.recordBytes-s.compressed)
+	fmt.Fprintf(f, "layer: whisper-payload, envelopes: %d, unattributed: %d, bytes: %d, uncompressed-bytes: %d, saved-bytes: %d\n", s.envelopes, s.unattributed, s.whisperBytes, s.whisperFull, s.whisperFull-s.whisperBytes)
+	fmt.Fprintf(f, "layer: envelope, envelopes: %d, bytes: %d, uncompressed-bytes: %d, saved-bytes: %d\n", s.envelopes, s.wireBytes, s.wireFull, s.wireFull-s.wireBytes)
+	fmt.Fprintf(f, "received: %d, failed: %d, compress-wall-time: %s, decompress-wall-time: %s\n", s.received, s.failed, averageDuration(s.compressTime, s.records), averageDuration(s.decompressTime, s.received))
+	return nil
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	transport "github.com/status-im/status-protocol-go/transport/whisper"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// deliveryAttempts is how many times the envelopes monitor posts a message
+// before reporting it expired.
+const deliveryAttempts = 3
+
+// deliveryTracker follows what the envelopes monitor of the messenger says
+// about the messages we send. With confirmations, a message is sent once a
+// peer acknowledged the batch of its envelope; without them, as soon as the
+// envelope is written to a peer.
+type deliveryTracker struct {
+	sync.Mutex
+	confirmations bool
+
+	posted    map[string]time.Time
+	confirmed map[string]time.Duration // time until reported sent
+	expired   map[string]bool
+
+	acks, ackBytes int // confirmations received
+}
+
+func newDeliveryTracker(confirmations bool) *deliveryTracker {
+	return &deliveryTracker{
+		confirmations: confirmations,
+		posted:        make(map[string]time.Time),
+		confirmed:     make(map[string]time.Duration),
+		expired:       make(map[string]bool),
+	}
+}
+
+// Track follows the envelopes of a node, and returns the envelopes monitor
+// configuration of its messenger.
+func (t *deliveryTracker) Track(node *Bstatus) *transport.EnvelopesMonitorConfig {
+	node.wire.OnEvent(t.handleEvent)
+	return &transport.EnvelopesMonitorConfig{
+		EnvelopeEventsHandler: t,
+		MaxAttempts:           deliveryAttempts,
+	}
+}
+
+// Sent records a message posted by the node.
+func (t *deliveryTracker) Sent(id string) {
+	t.Lock()
+	defer t.Unlock()
+	if _, ok := t.posted[id]; !ok {
+		t.posted[id] = time.Now()
+	}
+}
+
+// handleEvent counts the confirmations received, and the bytes they take on
+// the wire: a messages response, carrying the errors of the batch, and a
+// batch acknowledgement, each with its message code.
+func (t *deliveryTracker) handleEvent(ev whisper.EnvelopeEvent) {
+	if ev.Event != whisper.EventBatchAcknowledged {
+		return
+	}
+
+	var payload []byte
+	if errors, ok := ev.Data.([]whisper.EnvelopeError); ok {
+		payload, _ = rlp.EncodeToBytes(whisper.NewMessagesResponse(ev.Batch, errors))
+	} else {
+		payload, _ = rlp.EncodeToBytes(ev.Batch)
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	t.acks++
+	t.ackBytes += len(payload) + 1
+}
+
+// EnvelopeSent is called by the envelopes monitor when messages were sent.
+func (t *deliveryTracker) EnvelopeSent(identifiers [][]byte) {
+	t.Lock()
+	defer t.Unlock()
+	for _, identifier := range identifiers {
+		id := "0x" + hex.EncodeToString(identifier)
+		posted, ok := t.posted[id]
+		if _, confirmed := t.confirmed[id]; !ok || confirmed {
+			continue
+		}
+		t.confirmed[id] = time.Since(posted)
+	}
+}
+
+// EnvelopeExpired is called by the envelopes monitor when messages were
+// given up on.
+func (t *deliveryTracker) EnvelopeExpired(identifiers [][]byte, err error) {
+	t.Lock()
+	defer t.Unlock()
+	for _, identifier := range identifiers {
+		t.expired["0x"+hex.EncodeToString(identifier)] = true
+	}
+}
+
+func (t *deliveryTracker) MailServerRequestCompleted(common.Hash, common.Hash, []byte, error) {}
+
+func (t *deliveryTracker) MailServerRequestExpired(common.Hash) {}
+
+// Save writes how many of the messages posted were reported sent or expired,
+// how long it took to report them sent, and the confirmations received. The
+// messages actually delivered are in the read files of the destinations.
+func (t *deliveryTracker) Save(path string) error {
+	t.Lock()
+	defer t.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var delays []time.Duration
+	for _, delay := range t.confirmed {
+		delays = append(delays, delay)
+	}
+	sort.Slice(delays, func(i, j int) bool { return delays[i] < delays[j] })
+	median := time.Duration(0)
+	if len(delays) != 0 {
+		median = delays[len(delays)/2]
+	}
+
+	// Messages can be reported sent after a retry expired
+	expired := 0
+	for id := range t.expired {
+		_, posted := t.posted[id]
+		if _, ok := t.confirmed[id]; posted && !ok {
+			expired++
+		}
+	}
+
+	posted := len(t.posted)
+	share := 0.0
+	if posted != 0 {
+		share = float64(len(t.confirmed)) / float64(posted)
+	}
+	fmt.Fprintf(f, "confirmations: %t, posted: %d, reported-sent: %d, reported-sent-share: %.3f, expired: %d, unresolved: %d, median-report-delay: %s\n", t.confirmations, posted, len(t.confirmed), share, expired, posted-len(t.confirmed)-expired, median)
+	fmt.Fprintf(f, "confirmations-received: %d, confirmation-bytes: %d\n", t.acks, t.ackBytes)
+	return nil
+}
+
+// Pending returns how many messages posted weren't reported sent or expired
+// yet.
+func (t *deliveryTracker) Pending() int {
+	t.Lock()
+	defer t.Unlock()
+	pending := 0
+	for id := range t.posted {
+		if _, confirmed := t.confirmed[id]; !confirmed && !t.expired[id] {
+			pending++
+		}
+	}
+	return pending
+}
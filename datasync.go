@@ -0,0 +1,232 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	datasyncnode "github.com/vacp2p/mvds/node"
+	datasyncproto "github.com/vacp2p/mvds/protobuf"
+)
+
+// Modes of datasync.
+const (
+	datasyncBatch       = "batch"
+	datasyncInteractive = "interactive"
+)
+
+// datasyncModes maps the modes of datasync to those of mvds.
+var datasyncModes = map[string]datasyncnode.Mode{
+	datasyncBatch:       datasyncnode.BATCH,
+	datasyncInteractive: datasyncnode.INTERACTIVE,
+}
+
+// Types of the records of mvds payloads.
+var syncRecordTypes = []string{"offers", "requests", "acks", "messages"}
+
+// payloadTraffic counts the mvds payloads sent or received, and the records
+// they carried, by type.
+type payloadTraffic struct {
+	payloads, bytes int
+	records         map[string]int
+}
+
+func (t *payloadTraffic) add(payload *datasyncproto.Payload, size int) {
+	t.payloads++
+	t.bytes += size
+	t.records["offers"] += len(payload.Offers)
+	t.records["requests"] += len(payload.Requests)
+	t.records["acks"] += len(payload.Acks)
+	t.records["messages"] += len(payload.Messages)
+}
+
+// messageSync is how a message was synced with a peer.
+type messageSync struct {
+	sends int // times sent, the first one included
+	size  int // bytes of its body
+	acks  int // acks received
+}
+
+// datasyncStats counts the payloads datasync (mvds) sends and receives, in
+// the datasync transport of the messenger, and for every message sent to a
+// peer how many times it was sent and acked.
+type datasyncStats struct {
+	sync.Mutex
+	node  *Bstatus
+	mode  string
+	epoch time.Duration
+
+	sent, received payloadTraffic
+	messages       map[string]*messageSync // by peer and message ID
+}
+
+func newDatasyncStats(node *Bstatus, mode string, epoch time.Duration) (*datasyncStats, error) {
+	if _, ok := datasyncModes[mode]; !ok {
+		return nil, fmt.Errorf("unknown datasync mode %q", mode)
+	}
+	return &datasyncStats{
+		node:     node,
+		mode:     mode,
+		epoch:    epoch,
+		sent:     payloadTraffic{records: make(map[string]int)},
+		received: payloadTraffic{records: make(map[string]int)},
+		messages: make(map[string]*messageSync),
+	}, nil
+}
+
+func messageKey(peer *ecdsa.PublicKey, id []byte) string {
+	return publicKeyToHex(peer) + "/" + hex.EncodeToString(id)
+}
+
+// Sent records a payload sent to a peer.
+func (s *datasyncStats) Sent(peer *ecdsa.PublicKey, payload *datasyncproto.Payload, size int) {
+	s.Lock()
+	defer s.Unlock()
+	s.sent.add(payload, size)
+	for _, message := range payload.Messages {
+		id := message.ID()
+		key := messageKey(peer, id[:])
+		state, ok := s.messages[key]
+		if !ok {
+			state = &messageSync{size: len(message.Body)}
+			s.messages[key] = state
+		}
+		state.sends++
+	}
+}
+
+// Received records a payload received from a peer.
+func (s *datasyncStats) Received(peer *ecdsa.PublicKey, payload *datasyncproto.Payload, size int) {
+	s.Lock()
+	defer s.Unlock()
+	s.received.add(payload, size)
+	for _, ack := range payload.Acks {
+		if state, ok := s.messages[messageKey(peer, ack)]; ok {
+			state.acks++
+		}
+	}
+}
+
+// count returns the number of rows of an mvds table.
+func (s *datasyncStats) count(table string) int {
+	var n int
+	if err := s.node.db.QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&n); err != nil {
+		return 0
+	}
+	return n
+}
+
+// Save writes the mode and epoch, the payloads sent and received with their
+// records by type, the retransmissions of messages, and how many times every
+// message was sent and acked.
+func (s *datasyncStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var epoch int64
+	s.node.db.QueryRow(`SELECT epoch FROM mvds_epoch`).Scan(&epoch)
+	fmt.Fprintf(f, "mode: %s, epoch-duration: %s, epochs: %d, messages-stored: %d, peers: %d\n", s.mode, s.epoch, epoch, s.count("mvds_messages"), s.count("mvds_peers"))
+
+	for _, direction := range []struct {
+		name    string
+		traffic payloadTraffic
+	}{{"sent", s.sent}, {"received", s.received}} {
+		fmt.Fprintf(f, "%s: payloads: %d, bytes: %d", direction.name, direction.traffic.payloads, direction.traffic.bytes)
+		for _, recordType := range syncRecordTypes {
+			fmt.Fprintf(f, ", %s: %d", recordType, direction.traffic.records[recordType])
+		}
+		fmt.Fprintln(f)
+	}
+
+	s.saveRetransmissions(f)
+
+	var keys []string
+	for key := range s.messages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		state := s.messages[key]
+		fmt.Fprintf(f, "message: %s, sends: %d, acks: %d\n", key, state.sends, state.acks)
+	}
+	return nil
+}
+
+// saveRetransmissions writes how many times messages were sent again until
+// acked, and the bytes of datasync messages that took.
+func (s *datasyncStats) saveRetransmissions(f *os.File) {
+	var delivered, retransmissions, retransmitted, acks int
+	for _, state := range s.messages {
+		if state.acks > 0 {
+			delivered++
+		}
+		acks += state.acks
+		retransmissions += state.sends - 1
+		retransmitted += (state.sends - 1) * state.size
+	}
+
+	perDelivered := 0.0
+	if delivered != 0 {
+		perDelivered = float64(retransmissions) / float64(delivered)
+	}
+	dropped, received := s.node.loss.Counts()
+	fmt.Fprintf(f, "original-sends: %d, delivered: %d, acks: %d, retransmissions: %d, retransmissions-per-delivered: %.2f, retransmission-bytes: %d\n", len(s.messages), delivered, acks, retransmissions, perDelivered, retransmitted)
+	fmt.Fprintf(f, "loss: %.2f, dropped: %d, received: %d\n", s.node.loss.rate, dropped, received)
+}
+
+// lossEmulator drops one-to-one messages received by a node before the
+// messenger retrieves them, as if they had been lost on the way, so
+// datasync has to send them again.
+type lossEmulator struct {
+	sync.Mutex
+	rate float64 // probability of dropping a message
+
+	dropped, received int
+}
+
+// Drop drops messages out of the filters of one-to-one chats.
+func (l *lossEmulator) Drop(b *Bstatus) error {
+	if l.rate == 0 {
+		return nil
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	for _, filter := range b.messenger.Filters() {
+		if !filter.OneToOne {
+			continue
+		}
+		f := b.shh.GetFilter(filter.FilterID)
+		if f == nil {
+			continue
+		}
+		// Messages kept are put back for the messenger to retrieve
+		for _, msg := range f.Retrieve() {
+			l.received++
+			if rand.Float64() < l.rate {
+				l.dropped++
+				continue
+			}
+			f.Trigger(msg)
+		}
+	}
+	return nil
+}
+
+// Counts returns the messages dropped and received so far.
+func (l *lossEmulator) Counts() (dropped, received int) {
+	l.Lock()
+	defer l.Unlock()
+	return l.dropped, l.received
+}
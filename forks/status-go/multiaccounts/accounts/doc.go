@@ -0,0 +1,6 @@
+package accounts
+
+const (
+	// NodeConfigTag tag for a node configuration.
+	NodeConfigTag = "node-config"
+)
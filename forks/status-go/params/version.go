@@ -0,0 +1,7 @@
+package params
+
+// Version is defined in VERSION file.
+var Version string
+
+// GitCommit is a commit hash.
+var GitCommit string
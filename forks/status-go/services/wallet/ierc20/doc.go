@@ -0,0 +1,3 @@
+package ierc20
+
+//go:generate abigen -sol ierc20.sol -pkg ierc20 -out ierc20.go
@@ -0,0 +1,5 @@
+// Package signal implements event-based signalling interface between status-go
+// and externally linked codebases like status-react or status-desktop.
+// Events are send asynchronously using OS-specific linking mechanisms. See sources
+// for implementation details.
+package signal
@@ -0,0 +1,7 @@
+// Package sqlite is responsible for creation of encrypted sqlite3 database using sqlcipher driver.
+// It is optimized for mobile usage as well.
+//
+// sqlite package also provides a capability to apply bindata migration.
+// You can keep your migrations close to your business logic and use this package
+// to create an encrypted sqlite3 database and then apply the migrations easily.
+package sqlite
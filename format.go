@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	v1 "github.com/status-im/status-protocol-go/v1"
+)
+
+// Wire formats of application messages. v1 messages are wrapped in a signed
+// StatusProtocolMessage, legacy ones are sent as encoded.
+const (
+	wireFormatV1     = "v1"
+	wireFormatLegacy = "legacy"
+)
+
+var wireFormats = []string{wireFormatV1, wireFormatLegacy}
+
+// formatTraffic counts the messages received in a single wire format.
+type formatTraffic struct {
+	messages int
+	bytes    int // transport payload, before decryption
+	decoded  int
+	failed   int
+}
+
+// formatCost is the cost of encoding the messages sent in a single wire
+// format.
+type formatCost struct {
+	messages int
+	bytes    int // application layer, before encryption
+	cpu      time.Duration
+}
+
+// formatStats measures the cost of sending messages in the wire format of
+// the node, and whether the messages received in each format can be decoded.
+// With compare, every message sent is also encoded in every format, without
+// sending it, so the formats are compared on the same workload.
+type formatStats struct {
+	sync.Mutex
+	format  string
+	compare bool
+
+	sent     int
+	sendTime time.Duration
+	sendCPU  time.Duration
+
+	encoded  map[string]*formatCost
+	received map[string]*formatTraffic
+}
+
+func newFormatStats(format string, compare bool) (*formatStats, error) {
+	switch format {
+	case wireFormatV1, wireFormatLegacy:
+	default:
+		return nil, fmt.Errorf("unknown wire format %q", format)
+	}
+
+	s := &formatStats{
+		format:   format,
+		compare:  compare,
+		encoded:  make(map[string]*formatCost),
+		received: make(map[string]*formatTraffic),
+	}
+	for _, format := range wireFormats {
+		s.encoded[format] = &formatCost{}
+		s.received[format] = &formatTraffic{}
+	}
+	return s, nil
+}
+
+// threadCPU returns the user and system CPU time used by the current thread,
+// which the goroutine has to be locked to.
+func threadCPU() time.Duration {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_THREAD, &usage); err != nil {
+		return 0
+	}
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano())
+}
+
+// measureCPU calls f, and returns the CPU time it used on the calling
+// goroutine. Work f hands over to other goroutines isn't counted.
+func measureCPU(f func()) time.Duration {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	start := threadCPU()
+	f()
+	return threadCPU() - start
+}
+
+// Sent records a message sent, how long sending it took, and the CPU time
+// the send call used.
+func (s *formatStats) Sent(elapsed, cpu time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+	s.sent++
+	s.sendTime += elapsed
+	s.sendCPU += cpu
+}
+
+// Compare encodes a payload sent in every wire format, the way the messenger
+// does, and records the bytes and CPU time it takes in each.
+func (s *formatStats) Compare(chatID string, payload []byte, identity *ecdsa.PrivateKey) error {
+	var legacy, wrapped []byte
+	var err error
+	legacyCPU := measureCPU(func() {
+		legacy, err = v1.EncodeMessage(v1.CreatePublicTextMessage(payload, 0, chatID))
+	})
+	if err != nil {
+		return err
+	}
+	wrapCPU := measureCPU(func() {
+		wrapped, err = v1.WrapMessageV1(legacy, identity)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	for format, cost := range map[string]formatCost{
+		wireFormatLegacy: {bytes: len(legacy), cpu: legacyCPU},
+		wireFormatV1:     {bytes: len(wrapped), cpu: legacyCPU + wrapCPU},
+	} {
+		total := s.encoded[format]
+		total.messages++
+		total.bytes += cost.bytes
+		total.cpu += cost.cpu
+	}
+	return nil
+}
+
+// Received records a message in the format it was sent in, and tries to
+// decode its application layer.
+func (s *formatStats) Received(msg *v1.StatusMessage) {
+	// Only v1 messages carry an application metadata signature
+	format := wireFormatLegacy
+	if msg.ApplicationMetadataLayerSigPubKey != nil {
+		format = wireFormatV1
+	}
+
+	// The decoder modifies the payload
+	payload := make([]byte, len(msg.DecryptedPayload))
+	copy(payload, msg.DecryptedPayload)
+	value, err := v1.NewMessageDecoder(bytes.NewReader(payload)).Decode()
+	_, ok := value.(v1.Message)
+
+	s.Lock()
+	defer s.Unlock()
+	traffic := s.received[format]
+	traffic.messages++
+	traffic.bytes += len(msg.TransportPayload)
+	if err == nil && ok {
+		traffic.decoded++
+	} else {
+		traffic.failed++
+	}
+}
+
+// Save writes the cost of sending in our format, the cost of encoding the
+// same messages in every format, and the messages received in each format.
+// Formats other than ours are flagged as mismatched, and as failing if any of
+// their messages could not be decoded.
+func (s *formatStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintf(f, "format: %s, sent: %d, average-send-time: %s, send-cpu-per-message: %s\n", s.format, s.sent, averageDuration(s.sendTime, s.sent), averageDuration(s.sendCPU, s.sent))
+
+	v1Bytes := s.encoded[wireFormatV1].bytes
+	for _, format := range wireFormats {
+		cost := s.encoded[format]
+		if cost.messages == 0 {
+			continue
+		}
+		var relative float64
+		if v1Bytes != 0 {
+			relative = float64(cost.bytes) / float64(v1Bytes)
+		}
+		fmt.Fprintf(f, "encoded: %s, messages: %d, bytes-per-message: %d, encode-cpu-per-message: %s, bytes-vs-v1: %.2f\n", format, cost.messages, cost.bytes/cost.messages, averageDuration(cost.cpu, cost.messages), relative)
+	}
+
+	for _, format := range wireFormats {
+		traffic := s.received[format]
+		if traffic.messages == 0 {
+			continue
+		}
+		fmt.Fprintf(f, "received: %s, messages: %d, bytes-per-message: %d, decoded: %d, failed: %d", format, traffic.messages, traffic.bytes/traffic.messages, traffic.decoded, traffic.failed)
+		if format != s.format {
+			fmt.Fprintf(f, ", mismatch: true, interoperable: %t", traffic.failed == 0)
+		}
+		fmt.Fprintln(f)
+	}
+	return nil
+}
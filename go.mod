@@ -13,6 +13,8 @@ require (
 	github.com/ethereum/go-ethereum v1.8.27
 	github.com/fjl/memsize v0.0.0-20180929194037-2a09253e352a // indirect
 	github.com/golang/mock v1.3.1 // indirect
+	github.com/golang/protobuf v1.3.2
+	github.com/golang/snappy v0.0.1
 	github.com/gorilla/mux v1.7.3 // indirect
 	github.com/influxdata/influxdb v1.7.7 // indirect
 	github.com/karalabe/hid v1.0.0 // indirect
@@ -25,11 +27,14 @@ require (
 	github.com/multiformats/go-multihash v0.0.6 // indirect
 	github.com/opentracing/opentracing-go v1.1.0 // indirect
 	github.com/pborman/uuid v1.2.0 // indirect
+	github.com/pkg/errors v0.8.1
 	github.com/rjeczalik/notify v0.9.2 // indirect
 	github.com/sirupsen/logrus v1.4.2 // indirect
 	github.com/status-im/status-go v0.0.0-20190926070117-9a3ed980c9dc
 	github.com/status-im/status-protocol-go v0.0.0-20190926081215-cc44ddb7ce44
+	github.com/status-im/whisper v1.4.14
 	github.com/stretchr/objx v0.2.0 // indirect
+	github.com/vacp2p/mvds v0.0.21
 	go.opencensus.io v0.22.0 // indirect
 	google.golang.org/genproto v0.0.0-20190701230453-710ae3a149df // indirect
 	google.golang.org/grpc v1.22.0 // indirect
@@ -38,4 +43,8 @@ require (
 
 replace github.com/ethereum/go-ethereum v1.8.27 => github.com/status-im/go-ethereum v1.8.27-status.4
 
+replace github.com/status-im/status-go => ./forks/status-go
+
+replace github.com/status-im/status-protocol-go => ./forks/status-protocol-go
+
 go 1.13
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	status "github.com/status-im/status-protocol-go"
+	"github.com/status-im/status-protocol-go/encryption"
+	"github.com/status-im/status-protocol-go/encryption/multidevice"
+	v1 "github.com/status-im/status-protocol-go/v1"
+)
+
+func installationName(src string, n int) string {
+	return fmt.Sprintf("%s-%d", src, n)
+}
+
+// newInstallation returns another device of the same identity, with its own
+// node and installation ID. Connect needs to be called on it.
+func (b *Bstatus) newInstallation(src string, n int) *Bstatus {
+	id := installationName(src, n)
+	sourceDir := b.sourceDir + id + "/"
+
+	os.MkdirAll(sourceDir, os.ModePerm)
+
+	device := &Bstatus{
+		sourceDir:      sourceDir,
+		installationID: id,
+		privateKey:     b.privateKey,
+		fetchInterval:  b.fetchInterval,
+		fetchTimeout:   b.fetchTimeout,
+		fetchDone:      make(chan bool),
+		devices:        newDeviceStats(),
+		paired:         make(map[string]bool),
+		negotiations:   newNegotiationTracker(),
+		loss:           &lossEmulator{rate: b.loss.rate},
+		ttl:            b.ttl,
+		powTarget:      b.powTarget,
+	}
+	// Devices decode the messages of the others with the same codec
+	if b.codec != nil {
+		device.codec, _ = newCodecStats(device, b.codec.name, b.codec.content)
+	}
+	// Devices sync with the same datasync settings
+	if b.datasync != nil {
+		device.datasync, _ = newDatasyncStats(device, b.datasync.mode, b.datasync.epoch)
+	}
+	return device
+}
+
+// PairInstallation advertises this installation to the other devices of the
+// same identity, by sending a PairMessage to our own one-to-one chat.
+func (b *Bstatus) PairInstallation() error {
+	pairMessage := v1.CreatePairMessage(b.installationID, b.installationID, "bandwidth-test", "")
+	payload, err := v1.EncodePairMessage(pairMessage)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.fetchTimeout)
+	defer cancel()
+
+	publicKey := &b.privateKey.PublicKey
+	chat := status.CreateOneToOneChat(publicKeyToHex(publicKey), publicKey)
+	_, err = b.messenger.SendRaw(ctx, chat, payload)
+	return err
+}
+
+// handlePairMessage enables the installation advertised by a PairMessage sent
+// by one of our own devices. It returns false if msg is not a PairMessage.
+func (b *Bstatus) handlePairMessage(msg *v1.StatusMessage) (bool, error) {
+	if !isPubKeyEqual(msg.SigPubKey(), &b.privateKey.PublicKey) {
+		return false, nil
+	}
+
+	// The decoder modifies the payload
+	payload := make([]byte, len(msg.DecryptedPayload))
+	copy(payload, msg.DecryptedPayload)
+	pairMessage, err := v1.DecodePairMessage(payload)
+	if err != nil {
+		return false, nil
+	}
+
+	id := pairMessage.InstallationID
+	if id == b.installationID || b.paired[id] {
+		return true, nil
+	}
+
+	metadata := &multidevice.InstallationMetadata{
+		Name:       pairMessage.Name,
+		DeviceType: pairMessage.DeviceType,
+		FCMToken:   pairMessage.FCMToken,
+	}
+	if err := b.messenger.SetInstallationMetadata(id, metadata); err != nil {
+		return true, err
+	}
+	if err := b.messenger.EnableInstallation(id); err != nil {
+		return true, err
+	}
+	b.paired[id] = true
+
+	return true, nil
+}
+
+// enabledInstallations returns the number of our other devices that messages
+// are currently encrypted for.
+func (b *Bstatus) enabledInstallations() (int, error) {
+	installations, err := b.messenger.Installations()
+	if err != nil {
+		return 0, err
+	}
+	enabled := 0
+	for _, installation := range installations {
+		if installation.Enabled && installation.ID != b.installationID {
+			enabled++
+		}
+	}
+	return enabled, nil
+}
+
+// pairInstallations keeps advertising every device until each of them has
+// enabled all the others, or timeout is reached.
+func pairInstallations(devices []*Bstatus, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		paired := 0
+		for _, device := range devices {
+			enabled, err := device.enabledInstallations()
+			if err != nil {
+				return err
+			}
+			if enabled == len(devices)-1 {
+				paired++
+				continue
+			}
+			if err := device.PairInstallation(); err != nil {
+				return err
+			}
+		}
+
+		if paired == len(devices) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("only %d of %d installations paired", paired, len(devices))
+		}
+		time.Sleep(1 * time.Second)
+	}
+}
+
+// deviceStats groups received direct messages by the number of devices
+// the payload was encrypted for.
+type deviceStats struct {
+	sync.Mutex
+	messages map[int]int
+	bytes    map[int]int
+}
+
+func newDeviceStats() *deviceStats {
+	return &deviceStats{
+		messages: make(map[int]int),
+		bytes:    make(map[int]int),
+	}
+}
+
+func (s *deviceStats) Add(msg *v1.StatusMessage) {
+	var protocolMessage encryption.ProtocolMessage
+	if err := proto.Unmarshal(msg.TransportPayload, &protocolMessage); err != nil {
+		return
+	}
+
+	// Public messages are not encrypted per device
+	devices := len(protocolMessage.GetDirectMessage())
+	if devices == 0 {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.messages[devices]++
+	s.bytes[devices] += len(msg.TransportPayload)
+}
+
+// Save writes the average size of a message for each number of devices, and
+// how many bytes each device adds compared to the smallest fan-out seen.
+func (s *deviceStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var counts []int
+	for devices := range s.messages {
+		counts = append(counts, devices)
+	}
+	sort.Ints(counts)
+
+	var baseDevices, baseBytes int
+	for i, devices := range counts {
+		average := s.bytes[devices] / s.messages[devices]
+		if i == 0 {
+			baseDevices, baseBytes = devices, average
+		}
+
+		extra := 0
+		if devices > baseDevices {
+			extra = (average - baseBytes) / (devices - baseDevices)
+		}
+		fmt.Fprintf(f, "devices: %d, messages: %d, bytes: %d, extra-per-device: %d\n", devices, s.messages[devices], average, extra)
+	}
+	return nil
+}
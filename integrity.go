@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	v1 "github.com/status-im/status-protocol-go/v1"
+)
+
+// payloadHashes is the file every node logs the payloads it sends to.
+const payloadHashes = "payload-hashes.txt"
+
+// integritySeparator ends the harness key at the start of every payload.
+const integritySeparator = "|"
+
+// Outcomes of the verification of a received message.
+const (
+	integrityOK            = "ok"
+	integrityCorrupted     = "corrupted"
+	integrityTruncated     = "truncated"
+	integrityMisattributed = "wrongly-attributed"
+	integrityUnknown       = "unknown"     // not logged by any sender
+	integrityUndecodable   = "undecodable" // not a chat message
+)
+
+var integrityOutcomes = []string{integrityOK, integrityCorrupted, integrityTruncated, integrityMisattributed, integrityUnknown, integrityUndecodable}
+
+// payloadDigest identifies the content of a message and who sent it.
+type payloadDigest struct {
+	key    string
+	hash   string
+	size   int
+	sender string
+}
+
+// digest returns the digest of a payload. The messenger trims the text of
+// messages before sending them, so it is trimmed first.
+func digest(payload []byte, sender string) payloadDigest {
+	text := strings.TrimSpace(string(payload))
+	hash := sha256.Sum256([]byte(text))
+	return payloadDigest{key: payloadKey(text), hash: hex.EncodeToString(hash[:]), size: len(text), sender: sender}
+}
+
+// payloadKey returns the harness key a payload starts with, empty if none.
+func payloadKey(text string) string {
+	end := strings.Index(text, integritySeparator)
+	if end < 0 {
+		return ""
+	}
+	return text[:end]
+}
+
+// integrityStats checks that messages are received as they were sent.
+// Senders stamp every payload with a key of their own, the source and a
+// sequence number, and log its digest. Receivers check the messages they
+// decode against the logs of every node by key, as message IDs change
+// along with the payload.
+type integrityStats struct {
+	sync.Mutex
+	sourceDir string
+	src       string
+	log       *os.File
+
+	seq      int
+	received map[string]*payloadDigest // by message ID, nil if undecodable
+}
+
+func newIntegrityStats(sourceDir, src string) (*integrityStats, error) {
+	log, err := os.Create(sourceDir + payloadHashes)
+	if err != nil {
+		return nil, err
+	}
+	return &integrityStats{
+		sourceDir: sourceDir,
+		src:       src,
+		log:       log,
+		received:  make(map[string]*payloadDigest),
+	}, nil
+}
+
+// Stamp returns payload starting with the next key of the node. The key
+// replaces the start of payloads long enough, so their size is kept.
+func (s *integrityStats) Stamp(payload []byte) []byte {
+	s.Lock()
+	s.seq++
+	key := fmt.Sprintf("%s:%d%s", s.src, s.seq, integritySeparator)
+	s.Unlock()
+
+	stamped := []byte(key)
+	if len(payload) > len(key) {
+		return append(stamped, payload[len(key):]...)
+	}
+	return append(stamped, payload...)
+}
+
+// Sent logs the digest of a message sent by the node, by its key.
+func (s *integrityStats) Sent(id string, payload []byte, sender string) {
+	d := digest(payload, sender)
+
+	s.Lock()
+	defer s.Unlock()
+	fmt.Fprintf(s.log, "%s %s %s %d %s\n", d.key, id, d.hash, d.size, d.sender)
+}
+
+// Received records the digest of a message received by the node.
+func (s *integrityStats) Received(msg receivedMessage) {
+	id := "0x" + hex.EncodeToString(msg.ID)
+	var d *payloadDigest
+	if message, ok := msg.ParsedMessage.(v1.Message); ok {
+		received := digest([]byte(message.Text), publicKeyToHex(msg.SigPubKey()))
+		d = &received
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.received[id] = d
+}
+
+// loadDigests reads the payloads logged by every node of the run, by key.
+func (s *integrityStats) loadDigests() (map[string]payloadDigest, error) {
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(filepath.Clean(s.sourceDir)), "*", payloadHashes))
+	if err != nil {
+		return nil, err
+	}
+
+	digests := make(map[string]payloadDigest)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) != 5 {
+				continue
+			}
+			size, _ := strconv.Atoi(fields[3])
+			digests[fields[0]] = payloadDigest{key: fields[0], hash: fields[2], size: size, sender: fields[4]}
+		}
+		f.Close()
+	}
+	return digests, nil
+}
+
+// Save writes how many of the messages received had each outcome.
+func (s *integrityStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	digests, err := s.loadDigests()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	outcomes := make(map[string]int)
+	for _, received := range s.received {
+		if received == nil {
+			outcomes[integrityUndecodable]++
+			continue
+		}
+		sent, ok := digests[received.key]
+		switch {
+		case !ok:
+			outcomes[integrityUnknown]++
+		case received.sender != sent.sender:
+			outcomes[integrityMisattributed]++
+		case received.size < sent.size:
+			outcomes[integrityTruncated]++
+		case received.hash != sent.hash:
+			outcomes[integrityCorrupted]++
+		default:
+			outcomes[integrityOK]++
+		}
+	}
+
+	var fields []string
+	for _, outcome := range integrityOutcomes {
+		fields = append(fields, fmt.Sprintf("%s: %d", outcome, outcomes[outcome]))
+	}
+	fmt.Fprintf(f, "received: %d, %s\n", len(s.received), strings.Join(fields, ", "))
+	return nil
+}
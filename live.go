@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/p2p"
+)
+
+// liveStatsFile is rewritten by every node while it runs, for watch mode.
+const liveStatsFile = "live.txt"
+
+// liveInterval is how often the live stats are written.
+const liveInterval = 1 * time.Second
+
+// p2pTraffic returns the bytes a p2p traffic meter counted, 0 without
+// -metrics.
+func p2pTraffic(name string) int64 {
+	meter, ok := metrics.DefaultRegistry.Get(name).(metrics.Meter)
+	if !ok {
+		return 0
+	}
+	return meter.Count()
+}
+
+// liveStats writes what a node is doing to a file every second, while it
+// runs, so a run can be followed before the stats are saved.
+type liveStats struct {
+	node   *Bstatus
+	ready  *readiness
+	sender *resilientSender
+	path   string
+	quit   chan struct{}
+	done   chan struct{}
+}
+
+func newLiveStats(node *Bstatus, ready *readiness, sender *resilientSender, path string) *liveStats {
+	return &liveStats{
+		node:   node,
+		ready:  ready,
+		sender: sender,
+		path:   path,
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+func (s *liveStats) Start() {
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(liveInterval)
+		defer ticker.Stop()
+		for {
+			if err := s.write(); err != nil {
+				fmt.Printf("Error writing live stats: %+v", err)
+			}
+			select {
+			case <-ticker.C:
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops writing the live stats, once written a last time.
+func (s *liveStats) Stop() {
+	close(s.quit)
+	<-s.done
+	if err := s.write(); err != nil {
+		fmt.Printf("Error writing live stats: %+v", err)
+	}
+}
+
+// write writes the live stats to a temporary file, renamed once complete so
+// they are never read half written.
+func (s *liveStats) write() error {
+	sent, errors := s.sender.Counts()
+	received := 0
+	if s.node.sources != nil {
+		received = s.node.sources.Messages()
+	}
+	pending := 0
+	if s.node.deliveries != nil {
+		pending = s.node.deliveries.Pending()
+	}
+
+	f, err := os.Create(s.path + ".tmp")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(f, "time: %d, pid: %d, peers: %d, process-tx-bytes: %d, process-rx-bytes: %d, sent: %d, received: %d, pending: %d, errors: %d\n", time.Now().UnixNano(), os.Getpid(), s.ready.whisperPeers(), p2pTraffic(p2p.MetricsOutboundTraffic), p2pTraffic(p2p.MetricsInboundTraffic), sent, received, pending, errors)
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(s.path+".tmp", s.path)
+}
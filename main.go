@@ -16,7 +16,9 @@ import (
 	gonode "github.com/status-im/status-go/node"
 	params "github.com/status-im/status-go/params"
 	status "github.com/status-im/status-protocol-go"
+	transport "github.com/status-im/status-protocol-go/transport/whisper"
 	v1 "github.com/status-im/status-protocol-go/v1"
+	whisper "github.com/status-im/whisper/whisperv6"
 )
 
 type Bstatus struct {
@@ -27,24 +29,55 @@ type Bstatus struct {
 
 	// Whisper node settings
 	whisperDataDir string
+	ttl            uint32  // TTL in seconds of the envelopes sent, the messenger's if 0
+	powTarget      float64 // PoW target of the envelopes sent, the messenger's if 0
+
+	// installationID identifies this device among the installations
+	// sharing the same identity
+	installationID string
 
 	privateKey *ecdsa.PrivateKey  // secret for Status chat identity
 	nodeConfig *params.NodeConfig // configuration for Whisper node
 	statusNode *gonode.StatusNode // Ethereum Whisper node to run in background
+	db         *sql.DB            // database of the messenger
+	shh        *whisper.Whisper   // Whisper service of the node
+	wire       *wireTap           // traffic received by the Whisper service
 	messenger  *status.Messenger  // Status messaging layer instance
 
 	sourceDir      string
 	destinationDir string
+
+	paired   map[string]bool // our other installations that were enabled
+	devices  *deviceStats    // received messages grouped by device fan-out
+	replies  *replyModel     // replies to received messages, nil if disabled
+	topics   *topicRecorder  // paths and topics of the envelopes we sent
+	overhead *overheadStats  // key material in the envelopes we sent
+
+	negotiations *negotiationTracker // time to negotiate a topic with each peer
+	formats      *formatStats        // cost and decoding of wire formats, nil if not tracked
+	loss         *lossEmulator       // drops received one-to-one messages
+	persistence  *persistenceStats   // full retrieval path on disk, nil if not used
+	deliveries   *deliveryTracker    // sent messages as the envelopes monitor reports them, nil if not tracked
+	codec        *codecStats         // compression of the application layer, nil if not used
+	integrity    *integrityStats     // payloads received as sent, nil if not checked
+	ordering     *orderingStats      // order of arrival against Lamport clocks, nil if not checked
+	sources      *sourceStats        // received messages by sender, nil if not attributed
+	bloom        *bloomStats         // received envelopes an exact topic filter would drop
+	datasync     *datasyncStats      // datasync payloads sent and received, nil if datasync is off
+	pow          *powStats           // PoW of the envelopes and cost of sealing them
 }
 
-func (b *Bstatus) Connect(id, addr string, datasync, discovery bool) error {
-	key, err := crypto.GenerateKey()
-	if err != nil {
-		return err
+func (b *Bstatus) Connect(id, addr string, datasync, discovery, v1Messages bool, nodeOptions ...params.Option) error {
+	// Installations of the same identity are created with a shared key
+	if b.privateKey == nil {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			return err
+		}
+		b.privateKey = key
 	}
-	b.privateKey = key
 
-	b.nodeConfig = b.generateConfig(id, addr)
+	b.nodeConfig = b.generateConfig(id, addr, nodeOptions...)
 	b.statusNode = gonode.New()
 
 	accsMgr, _ := b.statusNode.AccountManager()
@@ -57,40 +90,85 @@ func (b *Bstatus) Connect(id, addr string, datasync, discovery bool) error {
 	if err != nil {
 		return err
 	}
-
-	// Using an in-memory SQLite DB since we have nothing worth preserving
-	db, _ := sql.Open("sqlite3", "file:mem?mode=memory&cache=shared")
+	b.shh = shhService
+	b.wire = newWireTap(shhService)
+	b.wire.Start()
+
+	// Using an in-memory SQLite DB since we have nothing worth preserving,
+	// unless the cost of persisting messages is measured.
+	// Each installation needs its own DB, as they may share the process.
+	dataSource := "file:" + id + "?mode=memory&cache=shared"
+	if b.persistence != nil {
+		dataSource = b.persistence.path
+	}
+	b.db, _ = sql.Open("sqlite3", dataSource)
 	options := []status.Option{
-		status.WithDatabase(db),
-		status.WithSendV1Messages(),
+		status.WithDatabase(b.db),
+		status.WithOnNegotiatedFilters(b.negotiations.Negotiated),
+	}
+
+	if b.persistence != nil {
+		options = append(options, status.WithMessagesPersistenceEnabled())
+	}
+
+	if b.deliveries != nil {
+		options = append(options, status.WithEnvelopesMonitorConfig(b.deliveries.Track(b)))
+	}
+
+	if v1Messages {
+		options = append(options, status.WithSendV1Messages())
+	}
+
+	if b.codec != nil {
+		options = append(options, status.WithApplicationCodec(b.codec.Encode, b.codec.Decode))
 	}
 
 	if datasync {
 		options = append(options, status.WithDatasync())
+		if b.datasync != nil {
+			options = append(options,
+				status.WithDatasyncMode(datasyncModes[b.datasync.mode]),
+				status.WithDatasyncEpoch(b.datasync.epoch),
+				status.WithDatasyncPayloadHandlers(b.datasync.Sent, b.datasync.Received),
+			)
+		}
 	}
 
 	if discovery {
 		options = append(options, status.WithGenericDiscoveryTopicSupport())
 	}
 
+	if b.ttl != 0 {
+		options = append(options, status.WithTTL(b.ttl))
+	}
+
+	if b.powTarget != 0 {
+		options = append(options, status.WithPoW(b.powTarget))
+	}
+
 	messenger, err := status.NewMessenger(
 		b.privateKey,
 		shhService,
-		"test-1",
+		b.installationID,
 		options...,
 	)
 	if err != nil {
 		return err
 	}
 	b.messenger = messenger
+	b.bloom = newBloomStats(b)
+	if b.persistence != nil {
+		b.persistence.Start()
+	}
 
 	go b.fetchMessagesLoop()
 
-	return crypto.SaveECDSA(b.sourceDir+"key.txt", key)
+	return crypto.SaveECDSA(b.sourceDir+"key.txt", b.privateKey)
 }
 
 func (b *Bstatus) Disconnect() error {
 	b.stopMessagesLoops()
+	b.wire.Stop()
 	if err := b.messenger.Shutdown(); err != nil {
 		return err
 	}
@@ -105,27 +183,62 @@ func (b *Bstatus) Send(chatID string, payload []byte) (string, error) {
 		return "", fmt.Errorf("Not connected")
 	}
 
+	if b.integrity != nil {
+		payload = b.integrity.Stamp(payload)
+	}
+
 	// Use a timeout for sending messages
 	ctx, cancel := context.WithTimeout(context.Background(), b.fetchTimeout)
 	defer cancel()
 
-	msgHash, err := b.messenger.Send(ctx, chatID, payload)
+	start := time.Now()
+	var msgHash []byte
+	var err error
+	cpu := measureCPU(func() {
+		msgHash, err = b.messenger.Send(ctx, chatID, payload)
+	})
 	if err != nil {
 		return "", err
 	}
+	if b.pow != nil {
+		b.pow.Send(time.Since(start), cpu)
+	}
+	if b.formats != nil {
+		b.formats.Sent(time.Since(start), cpu)
+		if b.formats.compare {
+			if err := b.formats.Compare(chatID, payload, b.privateKey); err != nil {
+				fmt.Printf("Error comparing wire formats: %+v", err)
+			}
+		}
+	}
+	b.negotiations.Sent(chatID)
+	if b.codec != nil {
+		b.codec.Sent(payload)
+	}
+	id := fmt.Sprintf("%#x", msgHash)
+	if b.persistence != nil {
+		b.persistence.Sent(id)
+	}
+	if b.deliveries != nil {
+		b.deliveries.Sent(id)
+	}
+	if b.integrity != nil {
+		b.integrity.Sent(id, payload, publicKeyToHex(&b.privateKey.PublicKey))
+	}
 	// TODO handle the delivery event?
-	return fmt.Sprintf("%#x", msgHash), nil
+	return id, nil
 }
 
 func (b *Bstatus) Connected() bool {
 	return b.statusNode.IsRunning()
 }
 
-func (b *Bstatus) generateConfig(id string, addr string) *params.NodeConfig {
+func (b *Bstatus) generateConfig(id string, addr string, nodeOptions ...params.Option) *params.NodeConfig {
 	options := []params.Option{
 		params.WithFleet(params.FleetBeta),
 		b.withListenAddr(addr),
 	}
+	options = append(options, nodeOptions...)
 
 	var configFiles []string
 	config, err := params.NewNodeConfigWithDefaultsAndFiles(
@@ -155,12 +268,53 @@ func (b *Bstatus) fetchMessagesLoop() {
 	for {
 		select {
 		case <-t.C:
-			messages, err := b.retrieveLatestMessages()
+			if err := b.loss.Drop(b); err != nil {
+				fmt.Printf("Error dropping messages: %+v", err)
+			}
+			var messages []receivedMessage
+			if b.persistence != nil {
+				messages, err = b.persistence.Retrieve()
+			} else {
+				messages, err = b.retrieveLatestMessages()
+			}
 			if err != nil {
 				continue
 			}
 			for _, msg := range messages {
-				privateRead.WriteString("0x" + hex.EncodeToString(msg.ID) + "\n")
+				if paired, err := b.handlePairMessage(msg.StatusMessage); paired {
+					if err != nil {
+						fmt.Printf("Error pairing installation: %+v", err)
+					}
+					continue
+				}
+				source, skip := "", false
+				if b.sources != nil {
+					source, skip = b.sources.Received(msg)
+				}
+				if skip {
+					continue
+				}
+				// Only messages of test nodes count as delivered
+				if source != sourceUnknown {
+					privateRead.WriteString("0x" + hex.EncodeToString(msg.ID) + "\n")
+				}
+				if b.integrity != nil {
+					b.integrity.Received(msg)
+				}
+				if b.ordering != nil {
+					b.ordering.Received(msg)
+				}
+				b.devices.Add(msg.StatusMessage)
+				// Persisted messages lose the payloads the wire format is told from
+				if b.formats != nil && b.persistence == nil {
+					b.formats.Received(msg.StatusMessage)
+				}
+				if msg.filter.OneToOne && !isPubKeyEqual(msg.SigPubKey(), &b.privateKey.PublicKey) {
+					b.negotiations.Received(publicKeyToHex(msg.SigPubKey()))
+				}
+				if b.replies != nil {
+					b.replies.Received(msg)
+				}
 			}
 		case <-b.fetchDone:
 			return
@@ -168,14 +322,22 @@ func (b *Bstatus) fetchMessagesLoop() {
 	}
 }
 
-func (b *Bstatus) retrieveLatestMessages() ([]*v1.StatusMessage, error) {
-	var msgs []*v1.StatusMessage
+// receivedMessage is a message along with the filter it was received on.
+type receivedMessage struct {
+	*v1.StatusMessage
+	filter transport.Filter
+}
+
+func (b *Bstatus) retrieveLatestMessages() ([]receivedMessage, error) {
+	var msgs []receivedMessage
 	rawMessages, err := b.messenger.RetrieveRawAll()
 	if err != nil {
 		return nil, err
 	}
-	for _, msg := range rawMessages {
-		msgs = append(msgs, msg...)
+	for filter, messages := range rawMessages {
+		for _, msg := range messages {
+			msgs = append(msgs, receivedMessage{StatusMessage: msg, filter: filter})
+		}
 	}
 	return msgs, nil
 }
@@ -212,6 +374,35 @@ func (b *Bstatus) JoinChannel(name string) error {
 	return nil
 }
 
+func (b *Bstatus) LeaveChannel(name string) error {
+	chat := status.CreatePublicChat(name)
+	if err := b.messenger.Leave(chat); err != nil {
+		return err
+	}
+
+	// Leave does not remove the filter of public chats, remove it ourselves
+	for _, filter := range b.messenger.Filters() {
+		if filter.ChatID == name {
+			return b.messenger.RemoveFilters([]*transport.Filter{filter})
+		}
+	}
+	return nil
+}
+
+// hasChat checks that a chat with the given ID has been saved
+func (b *Bstatus) hasChat(chatID string) bool {
+	chats, err := b.messenger.Chats()
+	if err != nil {
+		return false
+	}
+	for _, chat := range chats {
+		if chat.ID == chatID {
+			return true
+		}
+	}
+	return false
+}
+
 func (b *Bstatus) CreateOneToOne(name string, publicKey *ecdsa.PublicKey) error {
 	chat := status.CreateOneToOneChat(name, publicKey)
 	b.messenger.SaveChat(chat)
@@ -232,10 +423,63 @@ func main() {
 	publicChatID := flag.String("public-chat-id", "", "The public chat id to publish messages")
 	port := flag.Int("port", 30303, "The port to run geth on")
 	datasync := flag.Bool("datasync", true, "Enable datasync")
+	datasyncMode := flag.String("datasync-mode", datasyncBatch, "The mode of datasync: batch or interactive")
+	datasyncEpoch := flag.Duration("datasync-epoch", 300*time.Millisecond, "How often datasync sends")
 	discoveryTopic := flag.Bool("discovery", false, "Enabled discovery")
+	installations := flag.Int("installations", 1, "The number of paired installations (devices) sharing this identity")
+	lightClient := flag.Bool("light-client", false, "Run Whisper as a light client, advertising a bloom filter")
+	churnChats := flag.Int("churn-chats", 0, "The number of public chats to join and leave over time, 0 to disable")
+	churnJoined := flag.Int("churn-joined", 3, "The maximum number of churn chats joined at the same time")
+	churnInterval := flag.Duration("churn-interval", 5*time.Second, "The time between two churn chat joins or leaves")
+	traceFile := flag.String("trace", "", "A trace file to replay instead of sending random messages")
+	traceStart := flag.Int64("trace-start", 0, "The Unix time in ms the offsets of the trace count from, the same for every node, 0 for when the node is ready")
+	payloadSweep := flag.Bool("payload-sweep", false, "Send payloads of increasing size, up to and beyond the max message size, instead of random messages")
+	minPeers := flag.Int("min-peers", 1, "The Whisper peers to wait for before sending")
+	readyTimeout := flag.Duration("ready-timeout", 60*time.Second, "How long to wait for the node to be ready")
+	sendRetries := flag.Int("send-retries", 2, "How many times to retry a failed send")
+	sendBackoff := flag.Duration("send-backoff", 1*time.Second, "The time before retrying a failed send, doubled on every retry")
+	errorBudget := flag.Int("error-budget", 10, "How many sends can fail before the node gives up")
+	drain := flag.Duration("drain", 10*time.Second, "How long to keep receiving once sending stopped")
+	paddingAnalysis := flag.Bool("padding-analysis", false, "Analyse the padding and overhead of every envelope sent")
+	checkIntegrity := flag.Bool("integrity", false, "Stamp the payloads sent with a key and check the messages received against them")
+	codecName := flag.String("codec", "none", "The codec compressing the application layer of messages before encryption: none, gzip, snappy or deflate-dict")
+	payloadContent := flag.String("payload-content", contentTest, "The content of the messages sent: test, text or random")
+	confirmations := flag.Bool("confirmations", true, "Confirm the batches of envelopes received from peers")
+	persistence := flag.Bool("persistence", false, "Persist messages in an on-disk database and retrieve them the way clients do")
+	lossRate := flag.Float64("loss", 0, "The probability of dropping a received one-to-one message")
+	maxMessageSize := flag.Int("max-message-size", 0, "The max message size of Whisper, 0 for the default")
+	replyProbability := flag.Float64("reply-probability", 0, "The probability of replying to a received message in the same chat")
+	replyThinkTime := flag.Duration("reply-think-time", 3*time.Second, "The mean time before replying to a message")
+	replyDistribution := flag.String("reply-distribution", thinkExponential, "The distribution of think times: fixed, uniform or exponential")
+	maxPendingReplies := flag.Int("max-pending-replies", 10, "The most replies waiting for their think time at once, others are dropped")
+	wireFormat := flag.String("wire-format", wireFormatV1, "The wire format of sent messages: v1 or legacy")
+	compareFormats := flag.Bool("compare-formats", false, "Also encode every message sent in every wire format, without sending it")
+	minimumPoW := flag.Float64("min-pow", 0, "The minimum PoW of accepted envelopes, 0 for the default, at most the PoW of sent envelopes")
+	envelopeTTL := flag.Int("ttl", protocolTTL, "The TTL in seconds of the envelopes sent")
+	targetPoW := flag.Float64("pow-target", 0, "The PoW target of the envelopes sent, 0 for the messenger's")
+	watchMode := flag.Bool("watch", false, "Show the live stats of the nodes of a run, the nodes in -dst if set, instead of running a node")
+	// Only parsed so go-ethereum, which enables metrics when it finds it in
+	// os.Args, can be given the flag
+	flag.Bool("metrics", false, "Collect Whisper metrics, needed for the counters of rejected envelopes and traffic")
 
 	flag.Parse()
 
+	if *watchMode {
+		var nodes []string
+		flag.Visit(func(f *flag.Flag) {
+			if f.Name == "dst" {
+				nodes = strings.Split(*dst, ",")
+			}
+		})
+		if err := watch("/tmp", nodes, liveInterval); err != nil {
+			fmt.Printf("Error watching: %+v", err)
+		}
+		return
+	}
+
+	// Signals received while setting up stop sending as soon as it starts
+	shutdown := newShutdown(*drain)
+
 	now := time.Now()
 	until := now.Add(time.Duration(*numberOfSeconds) * time.Second)
 
@@ -243,119 +487,397 @@ func main() {
 
 	addr := fmt.Sprintf("[::]:%d", *port)
 
-	fmt.Printf("Src: %s, Dst: %s, NumberOfMessages: %d, NumberOfSeconds: %d, datasync: %s, discovery: %sPort: %d\n", *src, *dst, *numberOfMessages, *numberOfSeconds, *datasync, *discoveryTopic, *port)
+	fmt.Printf("Src: %s, Dst: %s, NumberOfMessages: %d, NumberOfSeconds: %d, datasync: %t, discovery: %t, installations: %d, wire-format: %s, Port: %d\n", *src, *dst, *numberOfMessages, *numberOfSeconds, *datasync, *discoveryTopic, *installations, *wireFormat, *port)
 
 	dsts := strings.Split(*dst, ",")
 	var destinations []Destination
 	sourceDir := "/tmp/" + *src + "/"
 
 	os.MkdirAll(sourceDir, os.ModePerm)
+	// The marker of a previous run would tell the files of this one complete
+	os.Remove(sourceDir + completionMarker)
+	os.Remove(sourceDir + liveStatsFile)
 
 	node := &Bstatus{
-		sourceDir:     sourceDir,
-		fetchInterval: 100 * time.Millisecond,
-		fetchTimeout:  1 * time.Second,
-	}
-	if err := node.Connect(*src, addr, *datasync, *discoveryTopic); err != nil {
-		fmt.Printf("Error connecting: %+v", err)
-		return
+		sourceDir:      sourceDir,
+		installationID: installationName(*src, 1),
+		fetchInterval:  100 * time.Millisecond,
+		fetchTimeout:   1 * time.Second,
+		fetchDone:      make(chan bool),
+		devices:        newDeviceStats(),
+		paired:         make(map[string]bool),
+		negotiations:   newNegotiationTracker(),
+		loss:           &lossEmulator{rate: *lossRate},
+		ttl:            uint32(*envelopeTTL),
+		powTarget:      *targetPoW,
 	}
 
-	// Wait for the other node to be ready, pull the key
+	sender := newResilientSender(node, shutdown, *sendRetries, *sendBackoff, *errorBudget)
 
-	for _, dst := range dsts {
-		if dst == *src {
-			continue
+	if *replyProbability > 0 {
+		replies, err := newReplyModel(node, sender, *replyProbability, *replyThinkTime, *replyDistribution, *maxPendingReplies)
+		if err != nil {
+			fmt.Printf("Error creating reply model: %+v", err)
+			return
 		}
-		var dstKey *ecdsa.PrivateKey
-		dstKeyFile := "/tmp/" + dst + "/key.txt"
+		node.replies = replies
+	}
 
-		for {
-			if _, err := os.Stat(dstKeyFile); err == nil {
-				dstKey, err = crypto.LoadECDSA(dstKeyFile)
-				if err != nil {
-					fmt.Printf("Error parsing key: %+v", err)
-					return
+	formats, err := newFormatStats(*wireFormat, *compareFormats)
+	if err != nil {
+		fmt.Printf("Error creating wire format stats: %+v", err)
+		return
+	}
+	node.formats = formats
 
-				}
-				chatID := fmt.Sprintf("0x%s", hex.EncodeToString(crypto.FromECDSAPub(&dstKey.PublicKey)))
-				destinations = append(destinations, Destination{id: dst, key: dstKey, chatID: chatID})
-				if err := node.CreateOneToOne(chatID, &dstKey.PublicKey); err != nil {
-					fmt.Printf("Error connecting: %+v", err)
-					return
-				}
-				break
-			}
+	if *persistence {
+		node.persistence = newPersistenceStats(node)
+	}
+	node.deliveries = newDeliveryTracker(*confirmations)
 
-			time.Sleep(1 * time.Second)
-		}
+	codec, err := newCodecStats(node, *codecName, *payloadContent)
+	if err != nil {
+		fmt.Printf("Error creating codec stage: %+v", err)
+		return
 	}
+	node.codec = codec
 
-	// Wait a bit, just to make sure all is ready
-	time.Sleep(5 * time.Second)
+	if *checkIntegrity {
+		integrity, err := newIntegrityStats(sourceDir, *src)
+		if err != nil {
+			fmt.Printf("Error creating payload hashes log: %+v", err)
+			return
+		}
+		node.integrity = integrity
+	}
+	node.ordering = newOrderingStats()
+	var testNodes []string
+	for _, dst := range strings.Split(*dst, ",") {
+		if dst != *src {
+			testNodes = append(testNodes, dst)
+		}
+	}
+	node.sources = newSourceStats(node, testNodes)
 
-	if *publicChatID != "" {
-		if err := node.JoinChannel(*publicChatID); err != nil {
-			fmt.Printf("Error connecting: %+v", err)
+	if *datasync {
+		node.datasync, err = newDatasyncStats(node, *datasyncMode, *datasyncEpoch)
+		if err != nil {
+			fmt.Printf("Error creating datasync stats: %+v", err)
 			return
 		}
 	}
 
-	publicWrite, err := os.Create(sourceDir + "public-write.txt")
-	if err != nil {
-		fmt.Printf("Error creating public file: %+v", err)
+	var nodeOptions []params.Option
+	if *lightClient {
+		nodeOptions = append(nodeOptions, node.withLightClient())
+	}
+	// Peers requiring more than the PoW we seal at reject our envelopes and
+	// disconnect
+	sentPoW := *targetPoW
+	if sentPoW == 0 {
+		sentPoW = protocolPoW
+	}
+	if *minimumPoW > sentPoW {
+		fmt.Printf("Error setting minimum PoW: %g is above the PoW of sent envelopes, %g", *minimumPoW, sentPoW)
 		return
 	}
+	if *minimumPoW > 0 {
+		nodeOptions = append(nodeOptions, node.withMinimumPoW(*minimumPoW))
+	}
+	if *maxMessageSize > 0 {
+		nodeOptions = append(nodeOptions, node.withMaxMessageSize(uint32(*maxMessageSize)))
+	}
+	if !*confirmations {
+		nodeOptions = append(nodeOptions, node.withoutConfirmations())
+	}
 
-	privateWrite, err := os.Create(sourceDir + "private-write.txt")
-	if err != nil {
-		fmt.Printf("Error creating private file: %+v", err)
+	v1Messages := *wireFormat == wireFormatV1
+	if err := node.Connect(*src, addr, *datasync, *discoveryTopic, v1Messages, nodeOptions...); err != nil {
+		fmt.Printf("Error connecting: %+v", err)
 		return
 	}
-	if _, err = node.messenger.LoadFilters(nil); err != nil {
-		fmt.Printf("Error loading filters: %+v", err)
+
+	topics, err := newTopicRecorder(node)
+	if err != nil {
+		fmt.Printf("Error creating topics log: %+v", err)
 		return
+	}
+	node.topics = topics
+	node.overhead = newOverheadStats(node)
+	if node.codec != nil {
+		node.codec.Start()
+	}
 
+	var padding *paddingStats
+	if *paddingAnalysis {
+		padding = newPaddingStats(node)
 	}
+	node.pow = newPoWStats(node, sentPoW)
 
-	rand.Seed(time.Now().Unix()) // initialize global pseudo random generator
-	sentMessages := 0
-	for {
-		if *publicChatID != "" {
-			id1, err := node.Send(*publicChatID, []byte("test"))
+	ttl := newTTLStats(node, time.Duration(*envelopeTTL)*time.Second)
+	ttl.Start()
+
+	// The other installations only receive, the first one does the sending
+	devices := []*Bstatus{node}
+	for i := 2; i <= *installations; i++ {
+		device := node.newInstallation(*src, i)
+		if err := device.Connect(device.installationID, "[::]:0", *datasync, *discoveryTopic, v1Messages, nodeOptions...); err != nil {
+			fmt.Printf("Error connecting installation: %+v", err)
+			return
+		}
+		devices = append(devices, device)
+	}
+
+	// Waiting for the keys of the other nodes counts towards the ready timeout
+	ready := &readiness{node: node, minPeers: *minPeers, timeout: *readyTimeout, start: time.Now(), stop: shutdown.stop}
+
+	live := newLiveStats(node, ready, sender, sourceDir+liveStatsFile)
+	live.Start()
+
+	// Getting ready runs as part of sending, so signals stop it, and a node
+	// that doesn't get ready drains and saves its stats like any other
+	var publicWrite, privateWrite *os.File
+	var churn *chatChurn
+	prepare := func() error {
+		// Wait for the other node to be ready, pull the key
+		for _, dst := range dsts {
+			if dst == *src {
+				continue
+			}
+			dstKey, err := ready.WaitKey("/tmp/" + dst + "/key.txt")
 			if err != nil {
-				fmt.Printf("Error connecting: %+v", err)
-				return
+				return err
+			}
+			chatID := fmt.Sprintf("0x%s", hex.EncodeToString(crypto.FromECDSAPub(&dstKey.PublicKey)))
+			destinations = append(destinations, Destination{id: dst, key: dstKey, chatID: chatID})
+			node.overhead.AddKey(dstKey)
+			if err := node.CreateOneToOne(chatID, &dstKey.PublicKey); err != nil {
+				return err
 			}
+		}
+
+		// Wait for the node to be peered
+		if err := ready.Wait(sourceDir + "readiness.txt"); err != nil {
+			return err
+		}
 
-			publicWrite.WriteString(id1 + "\n")
+		if *publicChatID != "" {
+			if err := node.JoinChannel(*publicChatID); err != nil {
+				return err
+			}
 		}
 
-		chatID := destinations[rand.Intn(len(destinations))].chatID
-		id2, err := node.Send(chatID, []byte("test"))
+		var err error
+		publicWrite, err = os.Create(sourceDir + "public-write.txt")
 		if err != nil {
-			fmt.Printf("Error connecting: %+v", err)
-			return
+			return err
 		}
 
-		privateWrite.WriteString(id2 + "\n")
+		privateWrite, err = os.Create(sourceDir + "private-write.txt")
+		if err != nil {
+			return err
+		}
+		for _, device := range devices {
+			if _, err = device.messenger.LoadFilters(nil); err != nil {
+				return err
+			}
+		}
 
-		time.Sleep(waitSeconds)
-		if *numberOfMessages != 0 {
-			sentMessages += 1
-			if sentMessages == *numberOfMessages {
-				break
+		if len(devices) > 1 {
+			if err := pairInstallations(devices, 30*time.Second); err != nil {
+				fmt.Printf("Error pairing installations: %+v", err)
 			}
 		}
 
-		if *numberOfSeconds != 0 {
-			if until.Before(time.Now()) {
-				break
+		rand.Seed(time.Now().Unix()) // initialize global pseudo random generator
+
+		if *churnChats != 0 {
+			churn, err = newChatChurn(node, *churnChats, *churnJoined, *churnInterval)
+			if err != nil {
+				return err
+			}
+			churn.Start()
+		}
+		return nil
+	}
+
+	// Sending runs until done, or until the node is stopped
+	if node.replies != nil {
+		shutdown.OnStop(node.replies.Stop)
+	}
+	send := func() error {
+		err := prepare()
+		if shutdown.Stopped() {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if *traceFile != "" {
+			var start time.Time
+			if *traceStart != 0 {
+				start = time.Unix(0, *traceStart*int64(time.Millisecond))
+			}
+			return replayTrace(node, sender, shutdown, *traceFile, *src, start, destinations, publicWrite, privateWrite)
+		}
+		if *payloadSweep {
+			return runPayloadSweep(node, shutdown, sourceDir+"payload-sweep.txt", publicWrite)
+		}
+
+		sentMessages := 0
+		for {
+			if *publicChatID != "" {
+				id1, err := sender.Send(*publicChatID, contentPayload(*payloadContent))
+				if err != nil {
+					return err
+				}
+
+				if id1 != "" {
+					publicWrite.WriteString(id1 + "\n")
+				}
+			}
+
+			if churn != nil {
+				if churnChatID := churn.RandomChat(); churnChatID != "" {
+					id, err := sender.Send(churnChatID, contentPayload(*payloadContent))
+					if err != nil {
+						return err
+					}
+
+					if id != "" {
+						publicWrite.WriteString(id + "\n")
+					}
+				}
 			}
+
+			chatID := destinations[rand.Intn(len(destinations))].chatID
+			id2, err := sender.Send(chatID, contentPayload(*payloadContent))
+			if err != nil {
+				return err
+			}
+
+			if id2 != "" {
+				privateWrite.WriteString(id2 + "\n")
+			}
+
+			if !shutdown.Wait(waitSeconds) {
+				return nil
+			}
+			if *numberOfMessages != 0 {
+				sentMessages += 1
+				if sentMessages == *numberOfMessages {
+					return nil
+				}
+			}
+
+			if *numberOfSeconds != 0 {
+				if until.Before(time.Now()) {
+					return nil
+				}
+			}
+		}
+	}
+
+	shutdown.Run(send)
+
+	if err := sender.Save(sourceDir + "send-errors.txt"); err != nil {
+		fmt.Printf("Error saving send error stats: %+v", err)
+	}
+
+	if node.replies != nil {
+		if err := node.replies.Save(sourceDir + "replies.txt"); err != nil {
+			fmt.Printf("Error saving reply stats: %+v", err)
 		}
+	}
 
+	if err := node.topics.Save(sourceDir + "topics.txt"); err != nil {
+		fmt.Printf("Error saving topic stats: %+v", err)
+	}
+
+	if node.datasync != nil {
+		if err := node.datasync.Save(sourceDir + "datasync.txt"); err != nil {
+			fmt.Printf("Error saving datasync stats: %+v", err)
+		}
 	}
 
+	ttl.Stop()
+	if err := ttl.Save(sourceDir + "ttl.txt"); err != nil {
+		fmt.Printf("Error saving TTL stats: %+v", err)
+	}
+
+	if err := node.pow.Save(sourceDir + "pow.txt"); err != nil {
+		fmt.Printf("Error saving PoW stats: %+v", err)
+	}
+
+	if err := node.sources.Save(sourceDir + "sources.txt"); err != nil {
+		fmt.Printf("Error saving source stats: %+v", err)
+	}
+
+	if err := node.ordering.Save(sourceDir + "ordering.txt"); err != nil {
+		fmt.Printf("Error saving ordering stats: %+v", err)
+	}
+
+	if node.integrity != nil {
+		if err := node.integrity.Save(sourceDir + "integrity.txt"); err != nil {
+			fmt.Printf("Error saving integrity stats: %+v", err)
+		}
+	}
+
+	if padding != nil {
+		if err := padding.Save(sourceDir + "padding.txt"); err != nil {
+			fmt.Printf("Error saving padding stats: %+v", err)
+		}
+	}
+
+	if node.codec != nil {
+		if err := node.codec.Save(sourceDir + "codec.txt"); err != nil {
+			fmt.Printf("Error saving codec stats: %+v", err)
+		}
+	}
+
+	if err := node.deliveries.Save(sourceDir + "confirmations.txt"); err != nil {
+		fmt.Printf("Error saving confirmation stats: %+v", err)
+	}
+
+	if node.persistence != nil {
+		if err := node.persistence.Save(sourceDir + "persistence.txt"); err != nil {
+			fmt.Printf("Error saving persistence stats: %+v", err)
+		}
+	}
+
+	if err := node.formats.Save(sourceDir + "wire-format.txt"); err != nil {
+		fmt.Printf("Error saving wire format stats: %+v", err)
+	}
+
+	if err := node.negotiations.Save(sourceDir + "negotiation.txt"); err != nil {
+		fmt.Printf("Error saving negotiation stats: %+v", err)
+	}
+
+	if err := node.overhead.Save(sourceDir + "key-overhead.txt"); err != nil {
+		fmt.Printf("Error saving key overhead stats: %+v", err)
+	}
+
+	if churn != nil {
+		churn.Stop()
+		if err := churn.Save(sourceDir + "churn.txt"); err != nil {
+			fmt.Printf("Error saving churn stats: %+v", err)
+		}
+	}
+
+	for _, device := range devices {
+		if err := device.devices.Save(device.sourceDir + "device-bytes.txt"); err != nil {
+			fmt.Printf("Error saving device stats: %+v", err)
+		}
+		if err := device.bloom.Save(device.sourceDir + "bloom.txt"); err != nil {
+			fmt.Printf("Error saving bloom stats: %+v", err)
+		}
+	}
+
+	live.Stop()
+
+	if err := shutdown.Complete(sourceDir+completionMarker, devices); err != nil {
+		fmt.Printf("Error writing completion marker: %+v", err)
+	}
 }
 
 func (b *Bstatus) withListenAddr(addr string) params.Option {
@@ -364,3 +886,31 @@ func (b *Bstatus) withListenAddr(addr string) params.Option {
 		return nil
 	}
 }
+
+func (b *Bstatus) withLightClient() params.Option {
+	return func(c *params.NodeConfig) error {
+		c.WhisperConfig.LightClient = true
+		return nil
+	}
+}
+
+func (b *Bstatus) withMinimumPoW(pow float64) params.Option {
+	return func(c *params.NodeConfig) error {
+		c.WhisperConfig.MinimumPoW = pow
+		return nil
+	}
+}
+
+func (b *Bstatus) withMaxMessageSize(size uint32) params.Option {
+	return func(c *params.NodeConfig) error {
+		c.WhisperConfig.MaxMessageSize = size
+		return nil
+	}
+}
+
+func (b *Bstatus) withoutConfirmations() params.Option {
+	return func(c *params.NodeConfig) error {
+		c.WhisperConfig.DisableConfirmations = true
+		return nil
+	}
+}
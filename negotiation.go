@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	transport "github.com/status-im/status-protocol-go/transport/whisper"
+)
+
+// peerNegotiation follows a conversation with a single peer, until both
+// sides derived a shared secret and a negotiated filter appeared.
+type peerNegotiation struct {
+	first      time.Time // first message sent or received
+	sent       int
+	received   int
+	negotiated bool
+	elapsed    time.Duration // from the first message to the negotiated filter
+	exchanged  int           // messages sent and received before that
+}
+
+// negotiationTracker measures how long new conversations stay on the
+// discovery and partitioned topics before a topic is negotiated.
+type negotiationTracker struct {
+	sync.Mutex
+	peers map[string]*peerNegotiation // by public key hex, as chat IDs
+}
+
+func newNegotiationTracker() *negotiationTracker {
+	return &negotiationTracker{
+		peers: make(map[string]*peerNegotiation),
+	}
+}
+
+func (n *negotiationTracker) peer(key string) *peerNegotiation {
+	p, ok := n.peers[key]
+	if !ok {
+		p = &peerNegotiation{first: time.Now()}
+		n.peers[key] = p
+	}
+	return p
+}
+
+// Sent records a message sent to chatID. Only one-to-one chats, whose ID is
+// the public key of the peer, are tracked.
+func (n *negotiationTracker) Sent(chatID string) {
+	key, err := hex.DecodeString(strings.TrimPrefix(chatID, "0x"))
+	if err != nil {
+		return
+	}
+	if _, err := crypto.UnmarshalPubkey(key); err != nil {
+		return
+	}
+
+	n.Lock()
+	defer n.Unlock()
+	n.peer(chatID).sent++
+}
+
+// Received records a one-to-one message received from peer.
+func (n *negotiationTracker) Received(peer string) {
+	n.Lock()
+	defer n.Unlock()
+	n.peer(peer).received++
+}
+
+// Negotiated is called by the messenger with the filters of new negotiated
+// topics.
+func (n *negotiationTracker) Negotiated(filters []*transport.Filter) {
+	n.Lock()
+	defer n.Unlock()
+
+	for _, filter := range filters {
+		if !filter.Negotiated {
+			continue
+		}
+		p := n.peer("0x" + filter.Identity)
+		if p.negotiated {
+			continue
+		}
+		p.negotiated = true
+		p.elapsed = time.Since(p.first)
+		p.exchanged = p.sent + p.received
+	}
+}
+
+// Save writes the time and messages each peer took to negotiate a topic, and
+// their distribution over the peers that did.
+func (n *negotiationTracker) Save(path string) error {
+	n.Lock()
+	defer n.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var durations []time.Duration
+	var exchanged []int
+	for key, p := range n.peers {
+		if !p.negotiated {
+			fmt.Fprintf(f, "peer: %s, negotiated: false, sent: %d, received: %d\n", key, p.sent, p.received)
+			continue
+		}
+		fmt.Fprintf(f, "peer: %s, negotiated: true, after: %s, messages: %d\n", key, p.elapsed, p.exchanged)
+		durations = append(durations, p.elapsed)
+		exchanged = append(exchanged, p.exchanged)
+	}
+
+	if len(durations) == 0 {
+		return nil
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	sort.Ints(exchanged)
+
+	last := len(durations) - 1
+	fmt.Fprintf(f, "negotiated: %d of %d, after min: %s, median: %s, p90: %s, max: %s\n", len(durations), len(n.peers), durations[0], durations[last/2], durations[last*9/10], durations[last])
+	fmt.Fprintf(f, "messages min: %d, median: %d, p90: %d, max: %d\n", exchanged[0], exchanged[last/2], exchanged[last*9/10], exchanged[last])
+	return nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	v1 "github.com/status-im/status-protocol-go/v1"
+)
+
+// arrival is a message as it arrived, in the order it arrived.
+type arrival struct {
+	clock     int64
+	timestamp int64
+}
+
+// orderingStats checks the order messages arrive in, for every chat of every
+// sender, against their Lamport clocks: messages arriving after a message
+// with a higher clock are out of order, and messages sent later with a
+// clock that isn't higher are clock regressions.
+type orderingStats struct {
+	sync.Mutex
+	seen    map[string]bool
+	streams map[string][]arrival // by sender and chat
+}
+
+func newOrderingStats() *orderingStats {
+	return &orderingStats{
+		seen:    make(map[string]bool),
+		streams: make(map[string][]arrival),
+	}
+}
+
+// Received records the arrival of a chat message.
+func (s *orderingStats) Received(msg receivedMessage) {
+	message, ok := msg.ParsedMessage.(v1.Message)
+	if !ok {
+		return
+	}
+	id := hex.EncodeToString(msg.ID)
+	stream := publicKeyToHex(msg.SigPubKey()) + "/" + message.Content.ChatID
+
+	s.Lock()
+	defer s.Unlock()
+	if s.seen[id] {
+		return
+	}
+	s.seen[id] = true
+	s.streams[stream] = append(s.streams[stream], arrival{clock: message.Clock, timestamp: int64(message.Timestamp)})
+}
+
+// streamOrder is the ordering of the messages of a stream.
+type streamOrder struct {
+	messages, outOfOrder, regressions int
+	totalDistance, maxDistance        int
+}
+
+func order(arrivals []arrival) streamOrder {
+	o := streamOrder{messages: len(arrivals)}
+
+	var maxClock int64
+	for i, a := range arrivals {
+		if i > 0 && a.clock < maxClock {
+			o.outOfOrder++
+		}
+		if a.clock > maxClock {
+			maxClock = a.clock
+		}
+	}
+
+	// The sequence number of a message is its rank by clock, the reordering
+	// distance how far from it it arrived
+	byClock := make([]int, len(arrivals))
+	for i := range byClock {
+		byClock[i] = i
+	}
+	sort.SliceStable(byClock, func(i, j int) bool { return arrivals[byClock[i]].clock < arrivals[byClock[j]].clock })
+	for sequence, i := range byClock {
+		distance := i - sequence
+		if distance < 0 {
+			distance = -distance
+		}
+		o.totalDistance += distance
+		if distance > o.maxDistance {
+			o.maxDistance = distance
+		}
+	}
+
+	bySent := make([]arrival, len(arrivals))
+	copy(bySent, arrivals)
+	sort.SliceStable(bySent, func(i, j int) bool { return bySent[i].timestamp < bySent[j].timestamp })
+	for i := 1; i < len(bySent); i++ {
+		if bySent[i].clock <= bySent[i-1].clock {
+			o.regressions++
+		}
+	}
+	return o
+}
+
+// Save writes, for every chat of every sender, how many messages arrived out
+// of order, the clock regressions, and the average and largest reordering
+// distance, followed by the totals.
+func (s *orderingStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var streams []string
+	for stream := range s.streams {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+
+	averageDistance := func(o streamOrder) float64 {
+		if o.messages == 0 {
+			return 0
+		}
+		return float64(o.totalDistance) / float64(o.messages)
+	}
+
+	var total streamOrder
+	for _, stream := range streams {
+		o := order(s.streams[stream])
+		fmt.Fprintf(f, "stream: %s, messages: %d, out-of-order: %d, clock-regressions: %d, average-distance: %.2f, max-distance: %d\n", stream, o.messages, o.outOfOrder, o.regressions, averageDistance(o), o.maxDistance)
+		total.messages += o.messages
+		total.outOfOrder += o.outOfOrder
+		total.regressions += o.regressions
+		total.totalDistance += o.totalDistance
+		if o.maxDistance > total.maxDistance {
+			total.maxDistance = o.maxDistance
+		}
+	}
+	fmt.Fprintf(f, "streams: %d, messages: %d, out-of-order: %d, clock-regressions: %d, average-distance: %.2f, max-distance: %d\n", len(streams), total.messages, total.outOfOrder, total.regressions, averageDistance(total), total.maxDistance)
+	return nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		arrivals []arrival
+		want     streamOrder
+	}{
+		{"empty", nil, streamOrder{}},
+		{"in order", []arrival{{1, 10}, {2, 20}, {3, 30}}, streamOrder{messages: 3}},
+		{
+			"swapped",
+			[]arrival{{2, 20}, {1, 10}, {3, 30}},
+			streamOrder{messages: 3, outOfOrder: 1, totalDistance: 2, maxDistance: 1},
+		},
+		{
+			"last first",
+			[]arrival{{3, 30}, {1, 10}, {2, 20}},
+			streamOrder{messages: 3, outOfOrder: 2, totalDistance: 4, maxDistance: 2},
+		},
+		{
+			"clock regression",
+			[]arrival{{1, 10}, {1, 20}, {2, 30}},
+			streamOrder{messages: 3, regressions: 1},
+		},
+	}
+	for _, test := range tests {
+		if got := order(test.arrivals); got != test.want {
+			t.Errorf("%s: order() = %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
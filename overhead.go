@@ -0,0 +1,153 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/status-im/status-protocol-go/encryption"
+	transport "github.com/status-im/status-protocol-go/transport/whisper"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// Kinds of sent envelopes, as far as key material is concerned.
+const (
+	overheadContactCode = "contact-code" // contact code publication, a bundle only
+	overheadBundle      = "bundle"       // chat message carrying a bundle
+	overheadChat        = "chat"         // chat message without a bundle
+	overheadUnopened    = "unopened"     // envelope we have no key for
+)
+
+var overheadKinds = []string{overheadContactCode, overheadBundle, overheadChat, overheadUnopened}
+
+// keyTraffic splits the bytes of envelopes into key material, X3DH bundles
+// and ratchet headers, and the encrypted payload they carry.
+type keyTraffic struct {
+	envelopes int
+	bytes     int // on the wire
+	keyBytes  int
+	payload   int
+}
+
+// overheadStats opens the envelopes posted by a node to tell how many of
+// their bytes are key material rather than chat. Private envelopes are
+// encrypted for the recipient, so they can only be opened with the keys of
+// the destinations, which the test nodes share through key.txt.
+type overheadStats struct {
+	sync.Mutex
+	node *Bstatus
+	keys []*ecdsa.PrivateKey
+
+	traffic map[string]keyTraffic
+}
+
+func newOverheadStats(node *Bstatus) *overheadStats {
+	s := &overheadStats{
+		node:    node,
+		traffic: make(map[string]keyTraffic),
+	}
+	node.wire.OnSent(s.Sent)
+	return s
+}
+
+// AddKey allows opening the private envelopes sent to the owner of key.
+func (s *overheadStats) AddKey(key *ecdsa.PrivateKey) {
+	s.Lock()
+	defer s.Unlock()
+	s.keys = append(s.keys, key)
+}
+
+// open decrypts an envelope with the key of its filter, or the keys of the
+// destinations, and returns the parsed Whisper message.
+func (s *overheadStats) open(envelope *whisper.Envelope, filter *transport.Filter) *whisper.ReceivedMessage {
+	var msg *whisper.ReceivedMessage
+	if filter != nil && filter.SymKeyID != "" {
+		key, err := s.node.shh.GetSymKey(filter.SymKeyID)
+		if err != nil {
+			return nil
+		}
+		msg, _ = envelope.OpenSymmetric(key)
+	} else {
+		s.Lock()
+		keys := s.keys
+		s.Unlock()
+		for _, key := range keys {
+			if msg, _ = envelope.OpenAsymmetric(key); msg != nil {
+				break
+			}
+		}
+	}
+
+	if msg == nil || !msg.ValidateAndParse() {
+		return nil
+	}
+	return msg
+}
+
+// Sent records an envelope posted by the node.
+func (s *overheadStats) Sent(envelope *whisper.Envelope) {
+	path, filter := s.node.topics.Classify(envelope.Topic)
+
+	kind := overheadUnopened
+	var protocolMessage encryption.ProtocolMessage
+	if msg := s.open(envelope, filter); msg != nil {
+		if err := proto.Unmarshal(msg.Payload, &protocolMessage); err == nil {
+			kind = overheadChat
+			if len(protocolMessage.GetBundles()) != 0 {
+				kind = overheadBundle
+			}
+			if path == pathContactCode {
+				kind = overheadContactCode
+			}
+		}
+	}
+
+	keyBytes := 0
+	for _, bundle := range protocolMessage.GetBundles() {
+		keyBytes += proto.Size(bundle)
+	}
+	payload := len(protocolMessage.GetPublicMessage())
+	for _, directMessage := range protocolMessage.GetDirectMessage() {
+		keyBytes += proto.Size(directMessage) - len(directMessage.GetPayload())
+		payload += len(directMessage.GetPayload())
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	traffic := s.traffic[kind]
+	traffic.envelopes++
+	traffic.bytes += envelopeSize(envelope)
+	traffic.keyBytes += keyBytes
+	traffic.payload += payload
+	s.traffic[kind] = traffic
+}
+
+// Save writes the traffic of each kind of envelope, and the share of the
+// bytes sent that were key material.
+func (s *overheadStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var total keyTraffic
+	for _, kind := range overheadKinds {
+		traffic := s.traffic[kind]
+		fmt.Fprintf(f, "%s: envelopes: %d, bytes: %d, key-bytes: %d, payload-bytes: %d\n", kind, traffic.envelopes, traffic.bytes, traffic.keyBytes, traffic.payload)
+		total.bytes += traffic.bytes
+		total.keyBytes += traffic.keyBytes
+	}
+
+	share := 0.0
+	if total.bytes != 0 {
+		share = float64(total.keyBytes) / float64(total.bytes)
+	}
+	fmt.Fprintf(f, "key-material-share: %.3f\n", share)
+	return nil
+}
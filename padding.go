@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// whisperPadding is the boundary Whisper pads messages to, and
+// paddingBoundaries the boundaries evaluated instead.
+const whisperPadding = 256
+
+var paddingBoundaries = []int{1, 16, 64, 128, whisperPadding, 512, 1024}
+
+// envelopeLayout is where the bytes of a sent envelope go.
+type envelopeLayout struct {
+	useful    int // Whisper payload
+	framing   int // flags and payload size
+	padding   int
+	signature int
+	crypto    int // ECIES or AES-GCM overhead
+	wire      int // with the envelope header, nonce included
+}
+
+// unpadded returns the size of the envelope without padding.
+func (l envelopeLayout) unpadded() int {
+	return l.wire - l.padding
+}
+
+// paddedWith returns the size of the envelope if it was padded to boundary.
+func (l envelopeLayout) paddedWith(boundary int) int {
+	raw := l.framing + l.useful + l.signature
+	return l.unpadded() + boundary - raw%boundary
+}
+
+// payloadSizeField returns the bytes Whisper uses for the size of a payload.
+func payloadSizeField(size int) int {
+	field := 1
+	for size >= 256 {
+		size >>= 8
+		field++
+	}
+	return field
+}
+
+// paddingStats opens every envelope a node sends to tell apart the bytes of
+// the payload, the padding and the rest of the overhead, and tells how
+// efficient other padding boundaries would be.
+type paddingStats struct {
+	sync.Mutex
+	node *Bstatus
+
+	layouts  []envelopeLayout
+	unopened int
+}
+
+func newPaddingStats(node *Bstatus) *paddingStats {
+	s := &paddingStats{node: node}
+	node.wire.OnSent(s.Sent)
+	return s
+}
+
+// Sent records the layout of an envelope posted by the node.
+func (s *paddingStats) Sent(envelope *whisper.Envelope) {
+	_, filter := s.node.topics.Classify(envelope.Topic)
+	msg := s.node.overhead.open(envelope, filter)
+
+	s.Lock()
+	defer s.Unlock()
+	if msg == nil {
+		s.unopened++
+		return
+	}
+
+	layout := envelopeLayout{
+		useful:    len(msg.Payload),
+		framing:   1 + payloadSizeField(len(msg.Payload)),
+		padding:   len(msg.Padding),
+		signature: len(msg.Signature),
+		wire:      envelopeSize(envelope),
+	}
+	layout.crypto = len(envelope.Data) - layout.framing - layout.useful - layout.padding - layout.signature
+	s.layouts = append(s.layouts, layout)
+}
+
+// bucket returns the power of two, from 64 bytes, a payload size falls under.
+func bucket(size int) int {
+	b := 64
+	for b < size {
+		b *= 2
+	}
+	return b
+}
+
+// Save writes, for every bucket of payload sizes, where the bytes of the
+// envelopes went and their efficiency, the useful bytes over the wire bytes,
+// followed by the efficiency of every padding boundary.
+func (s *paddingStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buckets := make(map[int][]envelopeLayout)
+	for _, layout := range s.layouts {
+		b := bucket(layout.useful)
+		buckets[b] = append(buckets[b], layout)
+	}
+	var sizes []int
+	for b := range buckets {
+		sizes = append(sizes, b)
+	}
+	sort.Ints(sizes)
+
+	efficiency := func(useful, wire int) float64 {
+		if wire == 0 {
+			return 0
+		}
+		return float64(useful) / float64(wire)
+	}
+
+	var total envelopeLayout
+	for _, size := range sizes {
+		var sum envelopeLayout
+		for _, layout := range buckets[size] {
+			sum.useful += layout.useful
+			sum.padding += layout.padding
+			sum.signature += layout.signature
+			sum.crypto += layout.crypto
+			sum.framing += layout.framing
+			sum.wire += layout.wire
+		}
+		overhead := sum.wire - sum.useful - sum.padding
+		fmt.Fprintf(f, "payload-up-to: %d, envelopes: %d, useful: %d, unpadded: %d, padding: %d, signature: %d, crypto: %d, overhead: %d, wire: %d, efficiency: %.3f\n", size, len(buckets[size]), sum.useful, sum.wire-sum.padding, sum.padding, sum.signature, sum.crypto, overhead, sum.wire, efficiency(sum.useful, sum.wire))
+		total.useful += sum.useful
+		total.padding += sum.padding
+		total.wire += sum.wire
+	}
+	fmt.Fprintf(f, "envelopes: %d, unopened: %d, useful: %d, padding: %d, wire: %d, efficiency: %.3f\n", len(s.layouts), s.unopened, total.useful, total.padding, total.wire, efficiency(total.useful, total.wire))
+
+	for _, boundary := range paddingBoundaries {
+		wire := 0
+		for _, layout := range s.layouts {
+			wire += layout.paddedWith(boundary)
+		}
+		fmt.Fprintf(f, "padding-boundary: %d, wire: %d, efficiency: %.3f\n", boundary, wire, efficiency(total.useful, wire))
+	}
+	return nil
+}
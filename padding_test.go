@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestPaddedWith(t *testing.T) {
+	// 100 bytes of payload, framing and signature, 156 of padding, and 50
+	// bytes of envelope header and encryption overhead
+	layout := envelopeLayout{useful: 33, framing: 2, signature: 65, padding: 156, wire: 306}
+
+	tests := []struct {
+		boundary int
+		want     int
+	}{
+		{256, 306},
+		{128, 178},
+		{100, 250}, // a full block is added to raw sizes on the boundary
+		{1, 151},
+	}
+	for _, test := range tests {
+		if got := layout.paddedWith(test.boundary); got != test.want {
+			t.Errorf("paddedWith(%d) = %d, want %d", test.boundary, got, test.want)
+		}
+	}
+}
+
+func TestPayloadSizeField(t *testing.T) {
+	tests := []struct {
+		size, want int
+	}{
+		{0, 1},
+		{255, 1},
+		{256, 2},
+		{65535, 2},
+		{65536, 3},
+	}
+	for _, test := range tests {
+		if got := payloadSizeField(test.size); got != test.want {
+			t.Errorf("payloadSizeField(%d) = %d, want %d", test.size, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/status-im/status-protocol-go/encryption"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// payloadSweepChat is the public chat the payload sweep is sent on, as the
+// envelopes of public chats can be opened by every node.
+const payloadSweepChat = "payload-sweep"
+
+// payloadSweepTimeout is how long to wait for the envelope of a message.
+const payloadSweepTimeout = 2 * time.Second
+
+// payloadSample is the size of a single payload through each layer.
+type payloadSample struct {
+	size     int
+	err      error
+	encoded  int // application message, encoded and wrapped
+	protocol int // ProtocolMessage, the Whisper payload
+	padding  int
+	envelope int // on the wire
+}
+
+// payloadSizes doubles the payload size up to maxSize, then closes in on it.
+// Sizes are within [1, maxSize], sorted and unique.
+func payloadSizes(maxSize int) []int {
+	if maxSize < 1 {
+		return nil
+	}
+	candidates := []int{maxSize / 2, maxSize - 1024, maxSize - 256, maxSize}
+	for size := 16; size < maxSize/2; size *= 2 {
+		candidates = append(candidates, size)
+	}
+	sort.Ints(candidates)
+
+	var sizes []int
+	for _, size := range candidates {
+		if size < 1 || size > maxSize {
+			continue
+		}
+		if len(sizes) > 0 && sizes[len(sizes)-1] == size {
+			continue
+		}
+		sizes = append(sizes, size)
+	}
+	return sizes
+}
+
+// runPayloadSweep sends payloads of increasing size to the payload sweep
+// chat, and writes how many bytes each of them takes through the layers,
+// and where sending fails. The sizes left are skipped if the node is stopped.
+func runPayloadSweep(node *Bstatus, shutdown *shutdown, path string, publicWrite *os.File) error {
+	if err := node.JoinChannel(payloadSweepChat); err != nil {
+		return err
+	}
+
+	topic := chatTopic(payloadSweepChat)
+	envelopes := make(chan *whisper.Envelope, 10)
+	node.wire.OnSent(func(envelope *whisper.Envelope) {
+		if envelope.Topic != topic {
+			return
+		}
+		select {
+		case envelopes <- envelope:
+		default:
+		}
+	})
+
+	// Whisper counts the envelopes it refuses to send as oversized
+	oversized := whisperCounter("envelopeErrOversized")
+	maxSize := int(node.shh.MaxMessageSize())
+
+	var samples []payloadSample
+sweep:
+	for _, size := range payloadSizes(maxSize) {
+		// Forget envelopes that arrived after their message timed out
+		for len(envelopes) > 0 {
+			<-envelopes
+		}
+
+		sample := payloadSample{size: size}
+		id, err := node.Send(payloadSweepChat, tracePayload(size))
+		if err != nil {
+			sample.err = err
+			samples = append(samples, sample)
+			continue
+		}
+		publicWrite.WriteString(id + "\n")
+
+		select {
+		case envelope := <-envelopes:
+			sample.envelope = envelopeSize(envelope)
+			_, filter := node.topics.Classify(envelope.Topic)
+			if msg := node.overhead.open(envelope, filter); msg != nil {
+				sample.protocol = len(msg.Payload)
+				sample.padding = len(msg.Padding)
+
+				var protocolMessage encryption.ProtocolMessage
+				if err := proto.Unmarshal(msg.Payload, &protocolMessage); err == nil {
+					sample.encoded = len(protocolMessage.GetPublicMessage())
+				}
+			}
+		case <-time.After(payloadSweepTimeout):
+			sample.err = fmt.Errorf("no envelope sent")
+		case <-shutdown.stop:
+			break sweep
+		}
+		samples = append(samples, sample)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	largest, smallestFailed := 0, 0
+	for _, sample := range samples {
+		if sample.err != nil {
+			fmt.Fprintf(f, "payload: %d, sent: false, error: %v\n", sample.size, sample.err)
+			if smallestFailed == 0 || sample.size < smallestFailed {
+				smallestFailed = sample.size
+			}
+			continue
+		}
+		if sample.size > largest {
+			largest = sample.size
+		}
+
+		share := 0.0
+		if sample.envelope != 0 {
+			share = float64(sample.padding) / float64(sample.envelope)
+		}
+		fmt.Fprintf(f, "payload: %d, sent: true, encoded: %d, whisper-payload: %d, padding: %d, envelope: %d, padding-share: %.3f\n", sample.size, sample.encoded, sample.protocol, sample.padding, sample.envelope, share)
+	}
+	fmt.Fprintf(f, "max-message-size: %d, largest-sent: %d, smallest-failed: %d, oversized-rejected-locally: %d\n", maxSize, largest, smallestFailed, whisperCounter("envelopeErrOversized")-oversized)
+	return nil
+}
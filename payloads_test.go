@@ -0,0 +1,42 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPayloadSizes(t *testing.T) {
+	tests := []struct {
+		maxSize int
+		want    []int
+	}{
+		{0, nil},
+		{1, []int{1}},
+		{2, []int{1, 2}},
+		{100, []int{16, 32, 50, 100}},
+		{1024, []int{16, 32, 64, 128, 256, 512, 768, 1024}},
+		{4096, []int{16, 32, 64, 128, 256, 512, 1024, 2048, 3072, 3840, 4096}},
+	}
+	for _, test := range tests {
+		got := payloadSizes(test.maxSize)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("payloadSizes(%d) = %v, want %v", test.maxSize, got, test.want)
+		}
+	}
+}
+
+func TestPayloadSizesLarge(t *testing.T) {
+	const maxSize = 1 << 20
+	sizes := payloadSizes(maxSize)
+	for i, size := range sizes {
+		if size < 1 || size > maxSize {
+			t.Fatalf("size %d out of [1, %d]", size, maxSize)
+		}
+		if i > 0 && size <= sizes[i-1] {
+			t.Fatalf("sizes not sorted and unique: %v", sizes)
+		}
+	}
+	if sizes[len(sizes)-1] != maxSize {
+		t.Errorf("last size %d, want %d", sizes[len(sizes)-1], maxSize)
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	status "github.com/status-im/status-protocol-go"
+	transport "github.com/status-im/status-protocol-go/transport/whisper"
+	v1 "github.com/status-im/status-protocol-go/v1"
+)
+
+// persistenceDB is the on-disk database of the messenger, in the source
+// directory, when messages are persisted.
+const persistenceDB = "messenger.db"
+
+// persistenceStats measures the local cost of the retrieval path real
+// clients use: RetrieveAll decodes messages, matches them to chats and saves
+// them in the database, where RetrieveRawAll only decodes them.
+type persistenceStats struct {
+	sync.Mutex
+	node *Bstatus
+	path string
+
+	sent map[string]bool // IDs of our messages, which RetrieveAll returns too
+
+	retrievals int
+	messages   int
+	own        int
+	cpu        time.Duration
+	elapsed    time.Duration
+	startSize  int64
+}
+
+func newPersistenceStats(node *Bstatus) *persistenceStats {
+	path := node.sourceDir + persistenceDB
+	// Start from an empty database on every run
+	for _, suffix := range []string{"", "-journal", "-wal", "-shm"} {
+		os.Remove(path + suffix)
+	}
+
+	return &persistenceStats{
+		node: node,
+		path: path,
+		sent: make(map[string]bool),
+	}
+}
+
+// size returns the bytes the database takes on disk, journal included.
+func (s *persistenceStats) size() int64 {
+	var size int64
+	for _, suffix := range []string{"", "-journal", "-wal"} {
+		if info, err := os.Stat(s.path + suffix); err == nil {
+			size += info.Size()
+		}
+	}
+	return size
+}
+
+// Start records the size of the database once the messenger created it.
+func (s *persistenceStats) Start() {
+	s.Lock()
+	defer s.Unlock()
+	s.startSize = s.size()
+}
+
+// Sent records the ID of a message sent by the node.
+func (s *persistenceStats) Sent(id string) {
+	s.Lock()
+	defer s.Unlock()
+	s.sent[id] = true
+}
+
+// Retrieve retrieves the latest messages through RetrieveAll, and returns
+// the ones received from others. Messages carry what RetrieveAll keeps of
+// them: no transport payload, and the chat instead of the filter.
+func (s *persistenceStats) Retrieve() ([]receivedMessage, error) {
+	var messages []*v1.Message
+	var err error
+	start := time.Now()
+	cpu := measureCPU(func() {
+		messages, err = s.node.messenger.RetrieveAll(context.Background(), status.RetrieveLatest)
+	})
+	elapsed := time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.retrievals++
+	s.cpu += cpu
+	s.elapsed += elapsed
+
+	var msgs []receivedMessage
+	for _, msg := range messages {
+		if s.sent["0x"+hex.EncodeToString(msg.ID)] {
+			s.own++
+			continue
+		}
+		s.messages++
+		msgs = append(msgs, receivedMessage{
+			StatusMessage: &v1.StatusMessage{
+				ID:                      msg.ID,
+				ParsedMessage:           *msg,
+				TransportLayerSigPubKey: msg.SigPubKey,
+			},
+			filter: transport.Filter{
+				ChatID:   msg.ChatID,
+				OneToOne: msg.MessageT == v1.MessageTypePrivate,
+			},
+		})
+	}
+	return msgs, nil
+}
+
+// Save writes the CPU and wall time spent retrieving, per message, and how
+// much the database grew.
+func (s *persistenceStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var saved int
+	s.node.db.QueryRow(`SELECT COUNT(*) FROM user_messages_legacy`).Scan(&saved)
+
+	growth := s.size() - s.startSize
+	perMessage := int64(0)
+	if saved != 0 {
+		perMessage = growth / int64(saved)
+	}
+	fmt.Fprintf(f, "retrievals: %d, messages: %d, own-messages: %d, cpu: %s, cpu-per-message: %s, retrieve-time-per-message: %s\n", s.retrievals, s.messages, s.own, s.cpu, averageDuration(s.cpu, s.messages), averageDuration(s.elapsed, s.messages))
+	fmt.Fprintf(f, "saved-messages: %d, db-bytes: %d, db-growth: %d, db-growth-per-message: %d\n", saved, s.size(), growth, perMessage)
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// protocolPoW is the PoW target the messenger seals envelopes with by
+// default.
+const protocolPoW = 0.002
+
+// whisperCounter returns the value of a Whisper metrics counter, 0 without
+// -metrics. Metrics add up the installations of the process.
+func whisperCounter(name string) int64 {
+	counter, ok := metrics.DefaultRegistry.Get("whisper/" + name).(metrics.Counter)
+	if !ok {
+		return 0
+	}
+	return counter.Count()
+}
+
+// powStats measures what the PoW target of the envelopes sent costs. The
+// messenger seals them on the send call, so its time is the sealing cost
+// along with encryption, to be compared across targets.
+type powStats struct {
+	sync.Mutex
+	node   *Bstatus
+	target float64
+
+	sends             int
+	sendTime, sendCPU time.Duration
+
+	sent        int
+	sentPoW     float64
+	received    int
+	receivedPoW float64
+	lowPoW      int64 // envelopes rejected for low PoW when we started
+}
+
+func newPoWStats(node *Bstatus, target float64) *powStats {
+	s := &powStats{
+		node:   node,
+		target: target,
+		lowPoW: whisperCounter("envelopeErrLowPow"),
+	}
+	node.wire.OnSent(s.Sent)
+	node.wire.OnReceived(s.Received)
+	return s
+}
+
+// Send records the wall and CPU time of a send call of the node.
+func (s *powStats) Send(elapsed, cpu time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+	s.sends++
+	s.sendTime += elapsed
+	s.sendCPU += cpu
+}
+
+// Sent records the PoW of an envelope posted by the node.
+func (s *powStats) Sent(envelope *whisper.Envelope) {
+	s.Lock()
+	defer s.Unlock()
+	s.sent++
+	s.sentPoW += envelope.PoW()
+}
+
+// Received records the PoW of an envelope received from a peer.
+func (s *powStats) Received(envelope *whisper.Envelope) {
+	s.Lock()
+	defer s.Unlock()
+	s.received++
+	s.receivedPoW += envelope.PoW()
+}
+
+// Save writes the PoW accepted and sent, the time of the send calls, which
+// seal at the target, and the envelopes rejected for a too low PoW.
+func (s *powStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	averagePoW := func(total float64, n int) float64 {
+		if n == 0 {
+			return 0
+		}
+		return total / float64(n)
+	}
+
+	fmt.Fprintf(f, "minimum-pow: %g, target-pow: %g, sent: %d, average-sent-pow: %g, received: %d, average-received-pow: %g\n", s.node.shh.MinPow(), s.target, s.sent, averagePoW(s.sentPoW, s.sent), s.received, averagePoW(s.receivedPoW, s.received))
+	fmt.Fprintf(f, "sends: %d, send-time: %s, send-cpu-time: %s\n", s.sends, averageDuration(s.sendTime, s.sends), averageDuration(s.sendCPU, s.sends))
+	fmt.Fprintf(f, "rejected-low-pow: %d\n", whisperCounter("envelopeErrLowPow")-s.lowPoW)
+	return nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// readinessInterval is how often readiness is checked.
+const readinessInterval = 500 * time.Millisecond
+
+// readiness is the state a node has to reach before it starts sending.
+type readiness struct {
+	node     *Bstatus
+	minPeers int
+	timeout  time.Duration
+	start    time.Time     // when waiting started, the timeout counting from it
+	stop     chan struct{} // closed when the node is stopped
+}
+
+// sleep waits for readinessInterval, and returns false if the node is
+// stopped.
+func (r *readiness) sleep() bool {
+	select {
+	case <-time.After(readinessInterval):
+		return true
+	case <-r.stop:
+		return false
+	}
+}
+
+// WaitKey waits for the key file of a destination to be written, and
+// returns the key.
+func (r *readiness) WaitKey(path string) (*ecdsa.PrivateKey, error) {
+	deadline := r.start.Add(r.timeout)
+	for {
+		if _, err := os.Stat(path); err == nil {
+			return crypto.LoadECDSA(path)
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("no key at %s after %s", path, r.timeout)
+		}
+		if !r.sleep() {
+			return nil, fmt.Errorf("stopped waiting for the key at %s", path)
+		}
+	}
+}
+
+// whisperPeers returns the peers running Whisper.
+func (r *readiness) whisperPeers() int {
+	peers := 0
+	for _, info := range r.node.statusNode.Server().PeersInfo() {
+		if _, ok := info.Protocols[whisper.ProtocolName]; ok {
+			peers++
+		}
+	}
+	return peers
+}
+
+// Wait waits until the node has enough Whisper peers. Peers failing the
+// Whisper handshake are disconnected, so peers are only counted once seen
+// twice in a row. The outcome is written to path.
+func (r *readiness) Wait(path string) error {
+	start := r.start
+	deadline := start.Add(r.timeout)
+
+	var peers, previous int
+	ready, stopped := false, false
+	for {
+		peers = r.whisperPeers()
+
+		stable := peers
+		if previous < stable {
+			stable = previous
+		}
+		previous = peers
+		if stable >= r.minPeers {
+			ready = true
+			break
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+		if !r.sleep() {
+			stopped = true
+			break
+		}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fmt.Fprintf(f, "ready: %t, waited: %s, whisper-peers: %d, min-peers: %d\n", ready, time.Since(start), peers, r.minPeers)
+
+	if stopped {
+		return fmt.Errorf("stopped waiting for %d Whisper peers", r.minPeers)
+	}
+	if !ready {
+		return fmt.Errorf("not ready after %s: %d Whisper peers", r.timeout, peers)
+	}
+	return nil
+}
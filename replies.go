@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+)
+
+// Think time distributions of the reply model.
+const (
+	thinkFixed       = "fixed"
+	thinkUniform     = "uniform"
+	thinkExponential = "exponential"
+)
+
+// replyModel answers received messages in the chat they were received on,
+// with a given probability and after some think time, so chats turn into
+// conversations instead of one-way random sends. Replies are answered too,
+// and every message answered in a public chat can be answered by every other
+// peer, so at most maxPending replies wait for their think time at once, the
+// others are dropped. Replies are sent through the sender of the node.
+type replyModel struct {
+	sync.Mutex
+	node         *Bstatus
+	sender       *resilientSender
+	probability  float64
+	thinkTime    time.Duration // mean think time
+	distribution string
+	maxPending   int
+
+	write *os.File
+
+	timers  map[int]*time.Timer // replies waiting for their think time
+	next    int
+	replies sync.WaitGroup // replies scheduled and not yet sent or cancelled
+	stopped bool
+
+	received, scheduled, dropped, cancelled, sent, failed int
+	totalThink                                            time.Duration
+}
+
+func newReplyModel(node *Bstatus, sender *resilientSender, probability float64, thinkTime time.Duration, distribution string, maxPending int) (*replyModel, error) {
+	switch distribution {
+	case thinkFixed, thinkUniform, thinkExponential:
+	default:
+		return nil, fmt.Errorf("unknown think time distribution %q", distribution)
+	}
+
+	write, err := os.Create(node.sourceDir + "reply-write.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	return &replyModel{
+		node:         node,
+		sender:       sender,
+		probability:  probability,
+		thinkTime:    thinkTime,
+		distribution: distribution,
+		maxPending:   maxPending,
+		write:        write,
+		timers:       make(map[int]*time.Timer),
+	}, nil
+}
+
+func (r *replyModel) think() time.Duration {
+	switch r.distribution {
+	case thinkUniform:
+		return time.Duration(rand.Int63n(2*int64(r.thinkTime) + 1))
+	case thinkExponential:
+		return time.Duration(rand.ExpFloat64() * float64(r.thinkTime))
+	}
+	return r.thinkTime
+}
+
+// Received decides whether to reply to msg, and schedules the reply.
+func (r *replyModel) Received(msg receivedMessage) {
+	// Don't answer our own messages
+	if isPubKeyEqual(msg.SigPubKey(), &r.node.privateKey.PublicKey) {
+		return
+	}
+
+	chatID := msg.filter.ChatID
+	if msg.filter.OneToOne {
+		chatID = publicKeyToHex(msg.SigPubKey())
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if r.stopped {
+		return
+	}
+	r.received++
+	if rand.Float64() >= r.probability {
+		return
+	}
+	if len(r.timers) >= r.maxPending {
+		r.dropped++
+		return
+	}
+
+	think := r.think()
+	r.scheduled++
+	r.totalThink += think
+	r.replies.Add(1)
+	r.next++
+	id := r.next
+	r.timers[id] = time.AfterFunc(think, func() {
+		defer r.replies.Done()
+		r.reply(id, chatID)
+	})
+}
+
+func (r *replyModel) reply(timer int, chatID string) {
+	r.Lock()
+	delete(r.timers, timer)
+	stopped := r.stopped
+	r.Unlock()
+
+	// Messages on filters like contact codes don't belong to any chat
+	if stopped || !r.node.hasChat(chatID) {
+		return
+	}
+
+	id, err := r.sender.Send(chatID, []byte("test"))
+
+	r.Lock()
+	defer r.Unlock()
+	if err != nil {
+		fmt.Printf("Error replying: %+v", err)
+	}
+	if id == "" {
+		r.failed++
+		return
+	}
+	r.sent++
+	r.write.WriteString(id + "\n")
+}
+
+// Stop cancels the replies waiting for their think time, and waits for the
+// replies being sent.
+func (r *replyModel) Stop() {
+	r.Lock()
+	r.stopped = true
+	for id, timer := range r.timers {
+		if timer.Stop() {
+			r.cancelled++
+			r.replies.Done()
+		}
+		delete(r.timers, id)
+	}
+	r.Unlock()
+	r.replies.Wait()
+}
+
+func (r *replyModel) Save(path string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintf(f, "received: %d, scheduled: %d, dropped: %d, cancelled: %d, sent: %d, failed: %d, average-think-time: %s\n", r.received, r.scheduled, r.dropped, r.cancelled, r.sent, r.failed, averageDuration(r.totalThink, r.scheduled))
+	return nil
+}
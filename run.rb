@@ -8,9 +8,38 @@ env = {
   'SECONDS' => 0,
   'APPLICATIONS' => 'id1',
   'DATASYNC' => 'false',
-  'DISCOVERY' => 'false'
+  'DATASYNC_MODE' => 'batch',
+  'DATASYNC_EPOCH' => '300ms',
+  'DISCOVERY' => 'false',
+  'INSTALLATIONS' => 1,
+  'LIGHT_CLIENT' => 'false',
+  'CHURN_CHATS' => 0,
+  'TRACE' => '',
+  'TRACE_DELAY' => 30,
+  'REPLY_PROBABILITY' => 0,
+  'WIRE_FORMAT' => 'v1',
+  'COMPARE_FORMATS' => 'false',
+  'MIN_POW' => 0,
+  'POW_TARGET' => 0,
+  'TTL' => 15,
+  'PAYLOAD_SWEEP' => 'false',
+  'MAX_MESSAGE_SIZE' => 0,
+  'LOSS' => 0,
+  'PERSISTENCE' => 'false',
+  'CONFIRMATIONS' => 'true',
+  'CODEC' => 'none',
+  'PAYLOAD_CONTENT' => 'test',
+  'PADDING_ANALYSIS' => 'false',
+  'INTEGRITY' => 'false',
+  'DRAIN' => '10s',
+  'SEND_RETRIES' => 2,
+  'ERROR_BUDGET' => 10,
+  'MIN_PEERS' => 1,
+  'READY_TIMEOUT' => '60s'
 }
 
+watch = false
+
 OptionParser.new do |parser|
   parser.on('-m', '--messages=n', OptionParser::DecimalInteger) do |m|
     env['MESSAGES'] = m
@@ -27,6 +56,119 @@ OptionParser.new do |parser|
   parser.on('-d', '--datasync') do |d|
     env['DATASYNC'] = 'true'
   end
+
+  parser.on('--datasync-mode=mode', ['batch', 'interactive']) do |m|
+    env['DATASYNC_MODE'] = m
+  end
+
+  parser.on('--datasync-epoch=duration') do |e|
+    env['DATASYNC_EPOCH'] = e
+  end
+
+  parser.on('-i', '--installations=n', OptionParser::DecimalInteger) do |i|
+    env['INSTALLATIONS'] = i
+  end
+
+  parser.on('-l', '--light-client') do |l|
+    env['LIGHT_CLIENT'] = 'true'
+  end
+
+  parser.on('-c', '--churn-chats=n', OptionParser::DecimalInteger) do |c|
+    env['CHURN_CHATS'] = c
+  end
+
+  parser.on('-t', '--trace=file') do |t|
+    env['TRACE'] = t
+  end
+
+  parser.on('--trace-delay=seconds', OptionParser::DecimalInteger) do |d|
+    env['TRACE_DELAY'] = d
+  end
+
+  parser.on('-r', '--reply-probability=p', Float) do |r|
+    env['REPLY_PROBABILITY'] = r
+  end
+
+  parser.on('-w', '--wire-format=format', ['v1', 'legacy', 'mixed']) do |w|
+    env['WIRE_FORMAT'] = w
+  end
+
+  parser.on('--compare-formats') do |c|
+    env['COMPARE_FORMATS'] = 'true'
+  end
+
+  parser.on('-p', '--min-pow=pow', Float) do |p|
+    env['MIN_POW'] = p
+  end
+
+  parser.on('--pow-target=pow', Float) do |p|
+    env['POW_TARGET'] = p
+  end
+
+  parser.on('--ttl=n', OptionParser::DecimalInteger) do |t|
+    env['TTL'] = t
+  end
+
+  parser.on('-b', '--payload-sweep') do |b|
+    env['PAYLOAD_SWEEP'] = 'true'
+  end
+
+  parser.on('--max-message-size=n', OptionParser::DecimalInteger) do |m|
+    env['MAX_MESSAGE_SIZE'] = m
+  end
+
+  parser.on('--loss=p', Float) do |l|
+    env['LOSS'] = l
+  end
+
+  parser.on('--persistence') do |p|
+    env['PERSISTENCE'] = 'true'
+  end
+
+  parser.on('--no-confirmations') do |c|
+    env['CONFIRMATIONS'] = 'false'
+  end
+
+  parser.on('--codec=codec', ['none', 'gzip', 'snappy', 'deflate-dict']) do |c|
+    env['CODEC'] = c
+  end
+
+  parser.on('--payload-content=content', ['test', 'text', 'random']) do |c|
+    env['PAYLOAD_CONTENT'] = c
+  end
+
+  parser.on('--padding-analysis') do |p|
+    env['PADDING_ANALYSIS'] = 'true'
+  end
+
+  parser.on('--integrity') do |i|
+    env['INTEGRITY'] = 'true'
+  end
+
+  parser.on('--drain=duration') do |d|
+    env['DRAIN'] = d
+  end
+
+  parser.on('--send-retries=n', OptionParser::DecimalInteger) do |r|
+    env['SEND_RETRIES'] = r
+  end
+
+  parser.on('--error-budget=n', OptionParser::DecimalInteger) do |e|
+    env['ERROR_BUDGET'] = e
+  end
+
+  parser.on('--min-peers=n', OptionParser::DecimalInteger) do |m|
+    env['MIN_PEERS'] = m
+  end
+
+  parser.on('--ready-timeout=duration') do |r|
+    env['READY_TIMEOUT'] = r
+  end
+
+  parser.on('--watch') do |w|
+    watch = true
+  end
+
   parser.on('-a', '--applications=n', OptionParser::DecimalInteger) do |app|
     applications = ''
     (1..app.to_i).each do |id|
@@ -52,6 +194,16 @@ puts "COMMAND: env #{env_string} ./run.sh"
 
 container = container.run("env #{env_string} ./run.sh")
 
+# The dashboard runs in the container, where the nodes write their live stats
+watcher = nil
+if watch
+  watcher = Thread.new do
+    container.exec(['./status-protocol-bandwidth-test', '-watch', "-dst=#{env['APPLICATIONS']}"]) do |stream, chunk|
+      print chunk
+    end
+  end
+end
+
 stats = nil
 while true do
   pulled_stats = container.stats
@@ -63,6 +215,8 @@ while true do
   end
 end
 
+watcher.join(5) if watcher
+
 if stats && stats['network']
   puts "SENT: #{stats['network']['tx_bytes']} bytes"
   puts "RECEIVED: #{stats['network']['rx_bytes']} bytes"
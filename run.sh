@@ -4,12 +4,25 @@ date
 
 PORT=30303
 PIDS=()
+
+# Nodes drain and save their stats when stopped
+trap 'kill -TERM "${PIDS[@]}" 2> /dev/null' INT TERM
+
+# Trace offsets count from the same time on every node, once they are ready
+TRACE_START=$(( ($(date +%s) + ${TRACE_DELAY:-30}) * 1000 ))
+
 IFS=', ' read -r -a array <<< "$APPLICATIONS"
 for element in "${array[@]}"
 do
     echo "$element"
     mkdir /tmp/$element -p
-    ./status-protocol-bandwidth-test -src="$element" -dst="$APPLICATIONS" -messages="${MESSAGES}"  -seconds="${SECONDS}" -public-chat-id="${PUBLIC_CHAT}" -port=$PORT -datasync=${DATASYNC} -discovery=${DISCOVERY} 2> /tmp/$element/log.txt &
+
+    # Mixed runs alternate v1 and legacy nodes
+    FORMAT=${WIRE_FORMAT:-v1}
+    if [ "$FORMAT" == "mixed" ]; then
+        if [ $(((PORT-30303) % 2)) -eq 0 ]; then FORMAT=v1; else FORMAT=legacy; fi
+    fi
+    ./status-protocol-bandwidth-test -src="$element" -dst="$APPLICATIONS" -messages="${MESSAGES}"  -seconds="${SECONDS}" -public-chat-id="${PUBLIC_CHAT}" -port=$PORT -datasync=${DATASYNC} -datasync-mode=${DATASYNC_MODE:-batch} -datasync-epoch=${DATASYNC_EPOCH:-300ms} -discovery=${DISCOVERY} -installations=${INSTALLATIONS:-1} -light-client=${LIGHT_CLIENT:-false} -churn-chats=${CHURN_CHATS:-0} -trace="${TRACE}" -trace-start=$TRACE_START -reply-probability=${REPLY_PROBABILITY:-0} -wire-format=$FORMAT -compare-formats=${COMPARE_FORMATS:-false} -min-pow=${MIN_POW:-0} -pow-target=${POW_TARGET:-0} -ttl=${TTL:-15} -payload-sweep=${PAYLOAD_SWEEP:-false} -max-message-size=${MAX_MESSAGE_SIZE:-0} -loss=${LOSS:-0} -persistence=${PERSISTENCE:-false} -confirmations=${CONFIRMATIONS:-true} -codec=${CODEC:-none} -payload-content=${PAYLOAD_CONTENT:-test} -padding-analysis=${PADDING_ANALYSIS:-false} -integrity=${INTEGRITY:-false} -drain=${DRAIN:-10s} -send-retries=${SEND_RETRIES:-2} -error-budget=${ERROR_BUDGET:-10} -min-peers=${MIN_PEERS:-1} -ready-timeout=${READY_TIMEOUT:-60s} -metrics 2> /tmp/$element/log.txt &
 
     PID=$!
     PIDS+=($PID)
@@ -17,10 +30,14 @@ do
     PORT=$((PORT+1))
 done
 
+# A trapped signal interrupts wait, so wait again until every node exited
 for pid in "${PIDS[@]}"
 do
   echo "Waiting for $pid"
-  wait $pid
+  while kill -0 $pid 2> /dev/null
+  do
+    wait $pid
+  done
 done
 
 echo "Done"
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/status-im/status-protocol-go/encryption"
+)
+
+// Classes of send errors.
+const (
+	sendErrorTimeout    = "timeout"
+	sendErrorEncryption = "encryption"
+	sendErrorDatasync   = "datasync"
+	sendErrorOther      = "other"
+)
+
+var sendErrorClasses = []string{sendErrorTimeout, sendErrorEncryption, sendErrorDatasync, sendErrorOther}
+
+// encryptionErrors are the errors of the encryption layer a send can fail
+// with.
+var encryptionErrors = []error{encryption.ErrDeviceNotFound, encryption.ErrNoPayload, encryption.ErrNotPairedDevice}
+
+// The messenger wraps the errors of datasync and encryption, which have no
+// error values of their own, with these messages.
+const (
+	wrapDatasync   = "failed to send message with datasync"
+	wrapEncryption = "failed to encrypt message"
+)
+
+// sendPeerWait is how long a send waits for peers when the node has none.
+const sendPeerWait = 10 * time.Second
+
+// resilientSender sends the messages of a node, retrying failed sends with
+// an exponential backoff, and only gives up once more sends failed than the
+// error budget allows. A send timing out may have posted its envelope
+// already, so it isn't retried, which would send the message twice.
+type resilientSender struct {
+	sync.Mutex
+	node     *Bstatus
+	shutdown *shutdown
+	retries  int
+	backoff  time.Duration
+	budget   int
+
+	sent, retried, failed, cancelled int
+	peerWaits                        int            // sends that waited for peers
+	peerWaitTime                     time.Duration  // time waited for peers
+	withoutPeers                     int            // attempts made while the node still had no peers
+	errors                           map[string]int // failed attempts by class
+}
+
+func newResilientSender(node *Bstatus, shutdown *shutdown, retries int, backoff time.Duration, budget int) *resilientSender {
+	return &resilientSender{
+		node:     node,
+		shutdown: shutdown,
+		retries:  retries,
+		backoff:  backoff,
+		budget:   budget,
+		errors:   make(map[string]int),
+	}
+}
+
+// classify returns the class of a send error, by the error it was caused
+// by, or by the message the messenger wrapped it with when there is no error
+// value to match.
+func classify(err error) string {
+	cause := errors.Cause(err)
+	if cause == context.DeadlineExceeded {
+		return sendErrorTimeout
+	}
+	for _, encryptionErr := range encryptionErrors {
+		if cause == encryptionErr {
+			return sendErrorEncryption
+		}
+	}
+
+	message := err.Error()
+	switch {
+	case strings.HasPrefix(message, wrapDatasync):
+		return sendErrorDatasync
+	case strings.HasPrefix(message, wrapEncryption):
+		return sendErrorEncryption
+	default:
+		return sendErrorOther
+	}
+}
+
+// peers returns the peers of the node.
+func (s *resilientSender) peers() int {
+	server := s.node.statusNode.Server()
+	if server == nil {
+		return 0
+	}
+	return server.PeerCount()
+}
+
+// waitForPeers waits up to sendPeerWait for the node to have peers, and
+// returns false if the node stopped meanwhile.
+func (s *resilientSender) waitForPeers() bool {
+	if s.peers() != 0 {
+		return true
+	}
+
+	start := time.Now()
+	for s.peers() == 0 && time.Since(start) < sendPeerWait {
+		if !s.shutdown.Wait(readinessInterval) {
+			return false
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.peerWaits++
+	s.peerWaitTime += time.Since(start)
+	return true
+}
+
+// Send sends a payload to a chat, and returns the ID of the message, or an
+// empty ID if sending failed or the node stopped while backing off. It
+// returns an error once the error budget is exceeded.
+func (s *resilientSender) Send(chatID string, payload []byte) (string, error) {
+	backoff := s.backoff
+	for attempt := 0; ; attempt++ {
+		// Envelopes sent without peers only reach the peers connecting
+		// before they expire, so peers are waited for first
+		if !s.waitForPeers() {
+			s.Lock()
+			s.cancelled++
+			s.Unlock()
+			return "", nil
+		}
+		if s.peers() == 0 {
+			s.Lock()
+			s.withoutPeers++
+			s.Unlock()
+		}
+
+		id, err := s.node.Send(chatID, payload)
+		if err == nil {
+			s.Lock()
+			s.sent++
+			s.Unlock()
+			return id, nil
+		}
+
+		class := classify(err)
+		fmt.Printf("Error sending (%s): %+v\n", class, err)
+		s.Lock()
+		s.errors[class]++
+		if attempt == s.retries || class == sendErrorTimeout {
+			s.failed++
+			failed := s.failed
+			s.Unlock()
+			if failed > s.budget {
+				return "", fmt.Errorf("error budget of %d failed sends exceeded: %v", s.budget, err)
+			}
+			return "", nil
+		}
+		s.retried++
+		s.Unlock()
+
+		if !s.shutdown.Wait(backoff) {
+			s.Lock()
+			s.cancelled++
+			s.Unlock()
+			return "", nil
+		}
+		backoff *= 2
+	}
+}
+
+// Save writes the messages sent, retried, failed and cancelled by the node
+// stopping, the waits for peers and the attempts still made without, and the
+// failed attempts for every class of error.
+func (s *resilientSender) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintf(f, "sent: %d, retried: %d, failed: %d, cancelled: %d, peer-waits: %d, average-peer-wait: %s, sent-without-peers: %d, error-budget: %d, exceeded: %t\n", s.sent, s.retried, s.failed, s.cancelled, s.peerWaits, averageDuration(s.peerWaitTime, s.peerWaits), s.withoutPeers, s.budget, s.failed > s.budget)
+	for _, class := range sendErrorClasses {
+		fmt.Fprintf(f, "class: %s, errors: %d\n", class, s.errors[class])
+	}
+	return nil
+}
+
+// Counts returns the messages sent and the failed attempts.
+func (s *resilientSender) Counts() (int, int) {
+	s.Lock()
+	defer s.Unlock()
+	errors := 0
+	for _, n := range s.errors {
+		errors += n
+	}
+	return s.sent, errors
+}
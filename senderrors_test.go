@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/status-im/status-protocol-go/encryption"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{context.DeadlineExceeded, sendErrorTimeout},
+		{errors.Wrap(context.DeadlineExceeded, "failed to send a message spec"), sendErrorTimeout},
+		{errors.Wrap(encryption.ErrDeviceNotFound, "failed to send a message spec"), sendErrorEncryption},
+		{errors.Wrap(errors.New("no session"), wrapEncryption), sendErrorEncryption},
+		{errors.Wrap(errors.New("not initialized"), wrapDatasync), sendErrorDatasync},
+		{errors.New("Not connected"), sendErrorOther},
+		{errors.New("message mentions datasync and encrypt"), sendErrorOther},
+	}
+	for _, test := range tests {
+		if got := classify(test.err); got != test.want {
+			t.Errorf("classify(%v) = %s, want %s", test.err, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// completionMarker is written by a node once it saved its stats and
+// disconnected, so the collator knows its files are complete.
+const completionMarker = "done.txt"
+
+// How sending ended.
+const (
+	shutdownCompleted   = "completed"
+	shutdownInterrupted = "interrupted"
+	shutdownFailed      = "failed"
+)
+
+// shutdown stops a node in order: sending stops when done or on SIGINT or
+// SIGTERM, the node keeps receiving for the drain period, so messages still
+// in flight are counted, and it is disconnected once its stats are saved.
+type shutdown struct {
+	drain   time.Duration
+	signals chan os.Signal
+	stop    chan struct{} // closed when sending should stop
+	onStop  []func()
+
+	status string
+	err    error
+}
+
+func newShutdown(drain time.Duration) *shutdown {
+	s := &shutdown{
+		drain:   drain,
+		signals: make(chan os.Signal, 1),
+		stop:    make(chan struct{}),
+		status:  shutdownCompleted,
+	}
+	signal.Notify(s.signals, syscall.SIGINT, syscall.SIGTERM)
+	return s
+}
+
+// Wait waits for d, and returns false if sending should stop.
+func (s *shutdown) Wait(d time.Duration) bool {
+	select {
+	case <-s.stop:
+		return false
+	default:
+	}
+	select {
+	case <-time.After(d):
+		return true
+	case <-s.stop:
+		return false
+	}
+}
+
+// Stopped returns whether sending should stop.
+func (s *shutdown) Stopped() bool {
+	select {
+	case <-s.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+// OnStop registers f to be called when sending stops, before the drain.
+func (s *shutdown) OnStop(f func()) {
+	s.onStop = append(s.onStop, f)
+}
+
+// Run sends until send returns or a signal arrives, then keeps receiving for
+// the drain period. On a signal, send is told to stop and waited for, so no
+// message is sent once the stats are saved. Another signal cuts the drain
+// short.
+func (s *shutdown) Run(send func() error) {
+	done := make(chan error, 1)
+	go func() {
+		done <- send()
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+		close(s.stop)
+	case sig := <-s.signals:
+		fmt.Printf("Received %s, stopping\n", sig)
+		s.status = shutdownInterrupted
+		close(s.stop)
+		err = <-done
+	}
+	if err != nil {
+		fmt.Printf("Error sending: %+v", err)
+		s.status = shutdownFailed
+		s.err = err
+	}
+	for _, f := range s.onStop {
+		f()
+	}
+
+	select {
+	case <-time.After(s.drain):
+	case sig := <-s.signals:
+		fmt.Printf("Received %s, skipping the drain period\n", sig)
+	}
+}
+
+// Complete disconnects the nodes and writes the completion marker.
+func (s *shutdown) Complete(path string, nodes []*Bstatus) error {
+	signal.Stop(s.signals)
+
+	disconnected := true
+	for _, node := range nodes {
+		if err := node.Disconnect(); err != nil {
+			fmt.Printf("Error disconnecting: %+v", err)
+			disconnected = false
+		}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	errorText := ""
+	if s.err != nil {
+		errorText = s.err.Error()
+	}
+	fmt.Fprintf(f, "status: %s, drain: %s, disconnected: %t, error: %q\n", s.status, s.drain, disconnected, errorText)
+	return nil
+}
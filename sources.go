@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	v1 "github.com/status-im/status-protocol-go/v1"
+)
+
+// Sources of received messages that aren't test nodes.
+const (
+	sourceSelf    = "self"    // echoes of our own messages
+	sourceUnknown = "unknown" // senders that aren't test nodes
+)
+
+// sourceTraffic is what was received from a source.
+type sourceTraffic struct {
+	messages int
+	bytes    int
+	empty    int
+}
+
+// sourceStats attributes every message received to the test node that sent
+// it, from the key that signed it, to our own node, or to an unknown sender.
+// Test nodes can send before we pulled their key, so keys are pulled again
+// when a message of an unknown sender arrives.
+type sourceStats struct {
+	sync.Mutex
+	node    *Bstatus
+	ids     []string
+	nodes   map[string]string // test node IDs by public key
+	missing map[string]bool   // test nodes whose key wasn't pulled yet
+
+	traffic map[string]sourceTraffic
+}
+
+func newSourceStats(node *Bstatus, ids []string) *sourceStats {
+	s := &sourceStats{
+		node:    node,
+		ids:     ids,
+		nodes:   make(map[string]string),
+		missing: make(map[string]bool),
+		traffic: make(map[string]sourceTraffic),
+	}
+	for _, id := range ids {
+		s.missing[id] = true
+	}
+	return s
+}
+
+// pullKeys pulls the keys of the test nodes we don't know yet.
+func (s *sourceStats) pullKeys() {
+	for id := range s.missing {
+		key, err := crypto.LoadECDSA("/tmp/" + id + "/key.txt")
+		if err != nil {
+			continue
+		}
+		s.nodes[publicKeyToHex(&key.PublicKey)] = id
+		delete(s.missing, id)
+	}
+}
+
+// Received attributes a message received, and returns its source and
+// whether it should be skipped.
+func (s *sourceStats) Received(msg receivedMessage) (string, bool) {
+	size := len(msg.TransportPayload)
+	skip := false
+	if message, ok := msg.ParsedMessage.(v1.Message); ok {
+		// Only messages retrieved through RetrieveAll come with their key
+		message.SigPubKey = msg.SigPubKey()
+		skip = s.node.skipMessage(&message)
+		if size == 0 {
+			size = len(message.Text)
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	source, ok := s.nodes[publicKeyToHex(msg.SigPubKey())]
+	if !ok && len(s.missing) != 0 {
+		s.pullKeys()
+		source, ok = s.nodes[publicKeyToHex(msg.SigPubKey())]
+	}
+	switch {
+	case isPubKeyEqual(msg.SigPubKey(), &s.node.privateKey.PublicKey):
+		source = sourceSelf
+	case !ok:
+		source = sourceUnknown
+	}
+
+	traffic := s.traffic[source]
+	traffic.messages++
+	traffic.bytes += size
+	if skip && source != sourceSelf {
+		traffic.empty++
+	}
+	s.traffic[source] = traffic
+	return source, skip
+}
+
+// Save writes the messages and bytes received from every source.
+func (s *sourceStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ids := append([]string{}, s.ids...)
+	sort.Strings(ids)
+
+	for _, source := range append(ids, sourceSelf, sourceUnknown) {
+		traffic := s.traffic[source]
+		fmt.Fprintf(f, "source: %s, messages: %d, bytes: %d, empty: %d\n", source, traffic.messages, traffic.bytes, traffic.empty)
+	}
+	return nil
+}
+
+// Messages returns how many messages were received from test nodes.
+func (s *sourceStats) Messages() int {
+	s.Lock()
+	defer s.Unlock()
+	messages := 0
+	for _, id := range s.ids {
+		messages += s.traffic[id].messages - s.traffic[id].empty
+	}
+	return messages
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	transport "github.com/status-im/status-protocol-go/transport/whisper"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// Paths an envelope can be sent on, after the transport method picked.
+const (
+	pathDiscovery   = "discovery"   // SendPrivateOnDiscovery, or a personal discovery topic
+	pathPartitioned = "partitioned" // SendPrivateWithPartitioned
+	pathNegotiated  = "negotiated"  // SendPrivateWithSharedSecret
+	pathContactCode = "contact-code"
+	pathPublic      = "public"
+	pathUnknown     = "unknown"
+)
+
+var topicPaths = []string{pathDiscovery, pathPartitioned, pathNegotiated, pathContactCode, pathPublic, pathUnknown}
+
+// topicBucket is the period the path mix is reported over.
+const topicBucket = 10 * time.Second
+
+// genericDiscoveryTopic is the generic discovery topic, which has no filter unless
+// generic discovery is enabled.
+var genericDiscoveryTopic = chatTopic("contact-discovery")
+
+// topicRecorder records the path and topic of every envelope posted by a
+// node, to show when private messages move from the discovery and
+// partitioned topics to the negotiated ones.
+type topicRecorder struct {
+	sync.Mutex
+	node    *Bstatus
+	start   time.Time
+	filters map[whisper.TopicType]*transport.Filter
+
+	log *os.File
+
+	buckets         []map[string]int
+	firstNegotiated map[string]time.Duration // by peer identity
+}
+
+func newTopicRecorder(node *Bstatus) (*topicRecorder, error) {
+	log, err := os.Create(node.sourceDir + "topics-log.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &topicRecorder{
+		node:            node,
+		start:           time.Now(),
+		filters:         make(map[whisper.TopicType]*transport.Filter),
+		log:             log,
+		firstNegotiated: make(map[string]time.Duration),
+	}
+	node.wire.OnSent(r.Sent)
+	return r, nil
+}
+
+// filter returns the filter installed for topic, if any. Installed filters
+// are looked up again for unknown topics, as negotiated filters show up over
+// time.
+func (r *topicRecorder) filter(topic whisper.TopicType) *transport.Filter {
+	if filter, ok := r.filters[topic]; ok {
+		return filter
+	}
+
+	for _, filter := range r.node.messenger.Filters() {
+		r.filters[filter.Topic] = filter
+	}
+	return r.filters[topic]
+}
+
+// Classify returns the path of envelopes sent on topic, and the filter
+// installed for it, if any.
+func (r *topicRecorder) Classify(topic whisper.TopicType) (string, *transport.Filter) {
+	r.Lock()
+	defer r.Unlock()
+	return r.classify(topic)
+}
+
+func (r *topicRecorder) classify(topic whisper.TopicType) (string, *transport.Filter) {
+	if topic == genericDiscoveryTopic {
+		return pathDiscovery, nil
+	}
+
+	filter := r.filter(topic)
+	switch {
+	case filter == nil:
+		return pathUnknown, nil
+	case filter.Negotiated:
+		return pathNegotiated, filter
+	case filter.Discovery:
+		return pathDiscovery, filter
+	case filter.OneToOne:
+		return pathPartitioned, filter
+	case strings.HasSuffix(filter.ChatID, "-contact-code"):
+		return pathContactCode, filter
+	}
+	return pathPublic, filter
+}
+
+// Sent records an envelope posted by the node.
+func (r *topicRecorder) Sent(envelope *whisper.Envelope) {
+	r.Lock()
+	defer r.Unlock()
+
+	path, filter := r.classify(envelope.Topic)
+	elapsed := time.Since(r.start)
+
+	bucket := int(elapsed / topicBucket)
+	for len(r.buckets) <= bucket {
+		r.buckets = append(r.buckets, make(map[string]int))
+	}
+	r.buckets[bucket][path]++
+
+	identity := "-"
+	if filter != nil && filter.Identity != "" {
+		identity = filter.Identity
+	}
+	if _, ok := r.firstNegotiated[identity]; path == pathNegotiated && !ok {
+		r.firstNegotiated[identity] = elapsed
+	}
+
+	r.log.WriteString(fmt.Sprintf("%d %s %s %s %d %s\n", time.Now().UnixNano()/int64(time.Millisecond), envelope.Hash().Hex(), path, envelope.Topic.String(), envelopeSize(envelope), identity))
+}
+
+// Save writes the number of envelopes sent on each path every topicBucket,
+// and when each peer was first sent a message on a negotiated topic.
+func (r *topicRecorder) Save(path string) error {
+	r.Lock()
+	defer r.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for i, bucket := range r.buckets {
+		fmt.Fprintf(f, "from: %s", time.Duration(i)*topicBucket)
+		for _, path := range topicPaths {
+			fmt.Fprintf(f, ", %s: %d", path, bucket[path])
+		}
+		fmt.Fprintln(f)
+	}
+	for identity, elapsed := range r.firstNegotiated {
+		fmt.Fprintf(f, "first-negotiated: %s, after: %s\n", identity, elapsed)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	traceChatPublic  = "public"
+	traceChatPrivate = "private"
+)
+
+// traceEntry is a single message of a trace file. Each line of the file is
+// a message, with whitespace separated fields:
+//
+//	<offset in ms> <sender> <public|private> <chat ID or recipient> <payload size>
+//
+// Empty lines and lines starting with # are ignored.
+type traceEntry struct {
+	offset   time.Duration // since the start of the replay
+	sender   string        // application id of the sending node
+	chatType string
+	chat     string // public chat name, or application id of the recipient
+	size     int    // payload size in bytes
+}
+
+func loadTrace(path string) ([]traceEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []traceEntry
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		entry, err := parseTraceEntry(text)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].offset < entries[j].offset
+	})
+	return entries, nil
+}
+
+func parseTraceEntry(text string) (traceEntry, error) {
+	fields := strings.Fields(text)
+	if len(fields) != 5 {
+		return traceEntry{}, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	offset, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return traceEntry{}, fmt.Errorf("invalid offset: %v", err)
+	}
+
+	chatType := fields[2]
+	if chatType != traceChatPublic && chatType != traceChatPrivate {
+		return traceEntry{}, fmt.Errorf("invalid chat type %q", chatType)
+	}
+
+	size, err := strconv.Atoi(fields[4])
+	if err != nil || size < 0 {
+		return traceEntry{}, fmt.Errorf("invalid payload size %q", fields[4])
+	}
+
+	return traceEntry{
+		offset:   time.Duration(offset) * time.Millisecond,
+		sender:   fields[1],
+		chatType: chatType,
+		chat:     fields[3],
+		size:     size,
+	}, nil
+}
+
+// tracePayload returns size random printable bytes, so payloads look like
+// chat text rather than something trivially compressible.
+func tracePayload(size int) []byte {
+	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 "
+	payload := make([]byte, size)
+	for i := range payload {
+		payload[i] = letters[rand.Intn(len(letters))]
+	}
+	return payload
+}
+
+// replayTrace sends the messages of the trace file sent by src, at the same
+// offsets as in the trace, counted from start, or from now if zero. Messages
+// whose offset passed before the node got ready are sent right away. Every
+// public chat of the trace
+// is joined first, so the messages of the other nodes are received too.
+// Messages are sent through sender, and replaying stops with the node.
+func replayTrace(node *Bstatus, sender *resilientSender, shutdown *shutdown, path, src string, start time.Time, destinations []Destination, publicWrite, privateWrite *os.File) error {
+	entries, err := loadTrace(path)
+	if err != nil {
+		return err
+	}
+
+	recipients := make(map[string]string)
+	for _, destination := range destinations {
+		recipients[destination.id] = destination.chatID
+	}
+
+	joined := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.chatType == traceChatPublic && !joined[entry.chat] {
+			if err := node.JoinChannel(entry.chat); err != nil {
+				return err
+			}
+			joined[entry.chat] = true
+		}
+	}
+
+	if start.IsZero() {
+		start = time.Now()
+	}
+	for _, entry := range entries {
+		if entry.sender != src {
+			continue
+		}
+
+		if !shutdown.Wait(time.Until(start.Add(entry.offset))) {
+			return nil
+		}
+
+		if entry.chatType == traceChatPublic {
+			id, err := sender.Send(entry.chat, tracePayload(entry.size))
+			if err != nil {
+				return err
+			}
+			if id != "" {
+				publicWrite.WriteString(id + "\n")
+			}
+			continue
+		}
+
+		chatID, ok := recipients[entry.chat]
+		if !ok {
+			return fmt.Errorf("unknown recipient %q", entry.chat)
+		}
+		id, err := sender.Send(chatID, tracePayload(entry.size))
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			privateWrite.WriteString(id + "\n")
+		}
+	}
+	return nil
+}
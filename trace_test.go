@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTraceEntry(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  traceEntry
+		valid bool
+	}{
+		{"1500 id1 public status 120", traceEntry{offset: 1500 * time.Millisecond, sender: "id1", chatType: traceChatPublic, chat: "status", size: 120}, true},
+		{"0\tid2  private id1 0", traceEntry{sender: "id2", chatType: traceChatPrivate, chat: "id1"}, true},
+		{"1500 id1 public status", traceEntry{}, false},
+		{"1500 id1 public status 120 extra", traceEntry{}, false},
+		{"soon id1 public status 120", traceEntry{}, false},
+		{"1500 id1 group status 120", traceEntry{}, false},
+		{"1500 id1 public status -1", traceEntry{}, false},
+		{"1500 id1 public status big", traceEntry{}, false},
+	}
+	for _, test := range tests {
+		got, err := parseTraceEntry(test.text)
+		if (err == nil) != test.valid {
+			t.Errorf("parseTraceEntry(%q) error = %v, want valid %t", test.text, err, test.valid)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("parseTraceEntry(%q) = %+v, want %+v", test.text, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// protocolTTL is the TTL in seconds the messenger seals envelopes with by
+// default.
+const protocolTTL = 15
+
+// joinWindow is how long after a peer joined the envelopes sent to it are
+// counted as the pool relayed to a late-joining peer.
+const joinWindow = 1 * time.Second
+
+// ttlStats measures how the TTL of the envelopes of a run weighs on the pool
+// of a node and on the traffic relayed to late-joining peers.
+type ttlStats struct {
+	sync.Mutex
+	node  *Bstatus
+	ttl   time.Duration
+	start time.Time
+
+	peerEvents chan *p2p.PeerEvent
+	sub        event.Subscription
+	done       chan bool
+
+	received int
+	joined   map[enode.ID]time.Time // peers joining once the pool is full
+	relayed  topicTraffic           // sent to late-joining peers after they joined
+	expired  int
+
+	poolSamples, poolBytes, poolEnvelopes, maxPoolBytes int
+}
+
+func newTTLStats(node *Bstatus, ttl time.Duration) *ttlStats {
+	s := &ttlStats{
+		node:       node,
+		ttl:        ttl,
+		start:      time.Now(),
+		peerEvents: make(chan *p2p.PeerEvent, 10),
+		done:       make(chan bool),
+		joined:     make(map[enode.ID]time.Time),
+	}
+	node.wire.OnReceived(s.Received)
+	node.wire.OnEvent(s.handleEvent)
+	return s
+}
+
+func (s *ttlStats) Start() {
+	s.sub = s.node.statusNode.Server().SubscribeEvents(s.peerEvents)
+	go s.loop()
+}
+
+func (s *ttlStats) Stop() {
+	s.sub.Unsubscribe()
+	close(s.done)
+}
+
+func (s *ttlStats) loop() {
+	t := time.NewTicker(1 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case ev := <-s.peerEvents:
+			// Peers joining before a TTL has passed get a pool that
+			// is still filling up
+			warm := time.Since(s.start) > s.ttl
+			if ev.Type == p2p.PeerEventTypeAdd && warm {
+				s.Lock()
+				s.joined[ev.Peer] = time.Now()
+				s.Unlock()
+			}
+		case <-t.C:
+			s.samplePool()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *ttlStats) samplePool() {
+	envelopes := s.node.shh.Envelopes()
+	bytes := 0
+	for _, envelope := range envelopes {
+		bytes += envelopeSize(envelope)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.poolSamples++
+	s.poolBytes += bytes
+	s.poolEnvelopes += len(envelopes)
+	if bytes > s.maxPoolBytes {
+		s.maxPoolBytes = bytes
+	}
+}
+
+// Received counts an envelope received from a peer.
+func (s *ttlStats) Received(envelope *whisper.Envelope) {
+	s.Lock()
+	defer s.Unlock()
+	s.received++
+}
+
+func (s *ttlStats) handleEvent(ev whisper.EnvelopeEvent) {
+	switch ev.Event {
+	case whisper.EventEnvelopeExpired:
+		s.Lock()
+		s.expired++
+		s.Unlock()
+	case whisper.EventEnvelopeSent:
+		s.Lock()
+		joined, ok := s.joined[ev.Peer]
+		s.Unlock()
+		if !ok || time.Since(joined) > joinWindow {
+			return
+		}
+
+		envelope := s.node.shh.GetEnvelope(ev.Hash)
+		if envelope == nil {
+			return
+		}
+		s.Lock()
+		s.relayed.envelopes++
+		s.relayed.bytes += envelopeSize(envelope)
+		s.Unlock()
+	}
+}
+
+// Save writes the pool, expiry and late-joining peer traffic measured with
+// the TTL of the run. Whether messages were still delivered with it is told
+// by the read files of the destinations.
+func (s *ttlStats) Save(path string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	averagePool, averageEnvelopes := 0, 0
+	if s.poolSamples != 0 {
+		averagePool = s.poolBytes / s.poolSamples
+		averageEnvelopes = s.poolEnvelopes / s.poolSamples
+	}
+	fmt.Fprintf(f, "ttl: %s, received: %d, pool-bytes: %d, max-pool-bytes: %d, pool-envelopes: %d, expired: %d, received-expired: %d\n", s.ttl, s.received, averagePool, s.maxPoolBytes, averageEnvelopes, s.expired, whisperCounter("envelopeErrExpired"))
+	fmt.Fprintf(f, "late-peers: %d, relayed-envelopes: %d, relayed-bytes: %d\n", len(s.joined), s.relayed.envelopes, s.relayed.bytes)
+	return nil
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// watchStale is how long a node can go without writing its live stats
+// before it is shown as stale.
+const watchStale = 5 * time.Second
+
+// watchTimeout is how long watch goes on without any node running.
+const watchTimeout = 60 * time.Second
+
+// nodeSnapshot is the live stats of a node at one time.
+type nodeSnapshot struct {
+	time                    time.Time
+	pid                     int
+	peers                   int
+	txBytes, rxBytes        int64 // of the whole process, installations included
+	sent, received, pending int
+	errors                  int
+}
+
+// readSnapshot reads the live stats written by a node.
+func readSnapshot(path string) (nodeSnapshot, error) {
+	var s nodeSnapshot
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return s, err
+	}
+
+	values := make(map[string]int64)
+	for _, field := range strings.Split(strings.TrimSpace(string(data)), ", ") {
+		parts := strings.SplitN(field, ": ", 2)
+		if len(parts) != 2 {
+			return s, fmt.Errorf("malformed field %q in %s", field, path)
+		}
+		value, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return s, err
+		}
+		values[parts[0]] = value
+	}
+
+	s.time = time.Unix(0, values["time"])
+	s.pid = int(values["pid"])
+	s.peers = int(values["peers"])
+	s.txBytes = values["process-tx-bytes"]
+	s.rxBytes = values["process-rx-bytes"]
+	s.sent = int(values["sent"])
+	s.received = int(values["received"])
+	s.pending = int(values["pending"])
+	s.errors = int(values["errors"])
+	return s, nil
+}
+
+// rate returns the bytes per second between two counts.
+func rate(previous, current int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 || current < previous {
+		return 0
+	}
+	return float64(current-previous) / elapsed.Seconds()
+}
+
+// dashboard shows the live stats of the nodes of a run, from the files they
+// write under /tmp, with rates from the previous refresh.
+type dashboard struct {
+	root     string
+	nodes    []string // the nodes to show, every node under root if empty
+	previous map[string]nodeSnapshot
+}
+
+func newDashboard(root string, nodes []string) *dashboard {
+	return &dashboard{
+		root:     root,
+		nodes:    nodes,
+		previous: make(map[string]nodeSnapshot),
+	}
+}
+
+// list returns the nodes to show.
+func (d *dashboard) list() ([]string, error) {
+	if len(d.nodes) != 0 {
+		return d.nodes, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(d.root, "*", liveStatsFile))
+	if err != nil {
+		return nil, err
+	}
+	var nodes []string
+	for _, path := range paths {
+		nodes = append(nodes, filepath.Base(filepath.Dir(path)))
+	}
+	sort.Strings(nodes)
+	return nodes, nil
+}
+
+// alive returns whether the process pid is running.
+func alive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// state returns whether a node is starting, running, stale, exited without
+// finishing, or done.
+func (d *dashboard) state(node string, snapshot nodeSnapshot, ok bool) string {
+	if _, err := os.Stat(filepath.Join(d.root, node, completionMarker)); err == nil {
+		return "done"
+	}
+	if !ok {
+		return "starting"
+	}
+	if !alive(snapshot.pid) {
+		return "exited"
+	}
+	if time.Since(snapshot.time) > watchStale {
+		return "stale"
+	}
+	return "running"
+}
+
+// Render writes the dashboard, and returns whether every node is done or
+// exited, and whether any is running.
+func (d *dashboard) Render(w *os.File) (finished, running bool, err error) {
+	nodes, err := d.list()
+	if err != nil {
+		return false, false, err
+	}
+
+	// Move to the top left and clear the screen
+	fmt.Fprint(w, "\033[H\033[2J")
+	fmt.Fprintf(w, "%s\n\n", time.Now().Format("15:04:05"))
+	fmt.Fprintf(w, "%-16s %-9s %6s %12s %12s %8s %9s %8s %7s\n", "NODE", "STATE", "PEERS", "PROC TX B/S", "PROC RX B/S", "SENT", "RECEIVED", "PENDING", "ERRORS")
+
+	var total nodeSnapshot
+	var totalTx, totalRx float64
+	finished = len(nodes) != 0
+	for _, node := range nodes {
+		snapshot, err := readSnapshot(filepath.Join(d.root, node, liveStatsFile))
+		ok := err == nil
+		state := d.state(node, snapshot, ok)
+		if state != "done" && state != "exited" {
+			finished = false
+		}
+		if state == "running" {
+			running = true
+		}
+		if !ok {
+			fmt.Fprintf(w, "%-16s %-9s\n", node, state)
+			continue
+		}
+
+		var tx, rx float64
+		if previous, seen := d.previous[node]; seen {
+			elapsed := snapshot.time.Sub(previous.time)
+			tx = rate(previous.txBytes, snapshot.txBytes, elapsed)
+			rx = rate(previous.rxBytes, snapshot.rxBytes, elapsed)
+		}
+		if state == "running" {
+			d.previous[node] = snapshot
+		} else {
+			tx, rx = 0, 0
+		}
+
+		fmt.Fprintf(w, "%-16s %-9s %6d %12.0f %12.0f %8d %9d %8d %7d\n", node, state, snapshot.peers, tx, rx, snapshot.sent, snapshot.received, snapshot.pending, snapshot.errors)
+		total.peers += snapshot.peers
+		totalTx += tx
+		totalRx += rx
+		total.sent += snapshot.sent
+		total.received += snapshot.received
+		total.pending += snapshot.pending
+		total.errors += snapshot.errors
+	}
+
+	fmt.Fprintf(w, "%-16s %-9s %6d %12.0f %12.0f %8d %9d %8d %7d\n", "TOTAL", fmt.Sprintf("%d nodes", len(nodes)), total.peers, totalTx, totalRx, total.sent, total.received, total.pending, total.errors)
+	return finished, running, nil
+}
+
+// watch refreshes the dashboard every interval, until every node is done or
+// exited, or no node ran for watchTimeout.
+func watch(root string, nodes []string, interval time.Duration) error {
+	d := newDashboard(root, nodes)
+	lastRunning := time.Now()
+	for {
+		finished, running, err := d.Render(os.Stdout)
+		if err != nil {
+			return err
+		}
+		if finished {
+			return nil
+		}
+		if running {
+			lastRunning = time.Now()
+		} else if time.Since(lastRunning) > watchTimeout {
+			return fmt.Errorf("no node running for %s", watchTimeout)
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReadSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		data  string
+		want  nodeSnapshot
+		valid bool
+	}{
+		{
+			"time: 1000000000, pid: 42, peers: 2, process-tx-bytes: 300, process-rx-bytes: 400, sent: 5, received: 6, pending: 1, errors: 0\n",
+			nodeSnapshot{time: time.Unix(1, 0), pid: 42, peers: 2, txBytes: 300, rxBytes: 400, sent: 5, received: 6, pending: 1},
+			true,
+		},
+		{"time: 1000000000, peers: 2\n", nodeSnapshot{time: time.Unix(1, 0), peers: 2}, true},
+		{"time: 1000000000, peers\n", nodeSnapshot{}, false},
+		{"time: now\n", nodeSnapshot{}, false},
+	}
+	for i, test := range tests {
+		path := filepath.Join(dir, liveStatsFile)
+		if err := ioutil.WriteFile(path, []byte(test.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := readSnapshot(path)
+		if (err == nil) != test.valid {
+			t.Errorf("%d: readSnapshot error = %v, want valid %t", i, err, test.valid)
+			continue
+		}
+		if test.valid && got != test.want {
+			t.Errorf("%d: readSnapshot = %+v, want %+v", i, got, test.want)
+		}
+	}
+
+	if _, err := readSnapshot(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Error("readSnapshot of a missing file succeeded")
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	whisper "github.com/status-im/whisper/whisperv6"
+)
+
+// sentDelay is how long an available envelope waits to be matched with a
+// received event, before being considered as posted by this node.
+const sentDelay = 500 * time.Millisecond
+
+// topicTraffic counts envelopes and their bytes on a single topic.
+type topicTraffic struct {
+	envelopes int
+	bytes     int
+}
+
+// wireTap follows the envelope events of a Whisper node, so the traffic
+// received from peers can be attributed to topics, and the envelopes posted
+// by this node can be told apart from the ones it relays.
+type wireTap struct {
+	sync.Mutex
+	shh    *whisper.Whisper
+	events chan whisper.EnvelopeEvent
+	sub    event.Subscription
+
+	received map[whisper.TopicType]topicTraffic
+
+	// Envelopes available to filters are either received from a peer or
+	// posted by us, the received event tells them apart.
+	available  map[common.Hash]time.Time
+	seen       map[common.Hash]time.Time
+	onSent     []func(*whisper.Envelope)
+	onReceived []func(*whisper.Envelope)
+	onEvent    []func(whisper.EnvelopeEvent)
+}
+
+func newWireTap(shh *whisper.Whisper) *wireTap {
+	return &wireTap{
+		shh:       shh,
+		events:    make(chan whisper.EnvelopeEvent, 100),
+		received:  make(map[whisper.TopicType]topicTraffic),
+		available: make(map[common.Hash]time.Time),
+		seen:      make(map[common.Hash]time.Time),
+	}
+}
+
+func (t *wireTap) Start() {
+	t.sub = t.shh.SubscribeEnvelopeEvents(t.events)
+	go t.loop()
+}
+
+func (t *wireTap) Stop() {
+	t.sub.Unsubscribe()
+}
+
+// OnSent registers f to be called with every envelope posted by this node.
+func (t *wireTap) OnSent(f func(*whisper.Envelope)) {
+	t.Lock()
+	defer t.Unlock()
+	t.onSent = append(t.onSent, f)
+}
+
+// OnReceived registers f to be called with every envelope received from a
+// peer and added to the pool.
+func (t *wireTap) OnReceived(f func(*whisper.Envelope)) {
+	t.Lock()
+	defer t.Unlock()
+	t.onReceived = append(t.onReceived, f)
+}
+
+// OnEvent registers f to be called with every envelope event of the node.
+func (t *wireTap) OnEvent(f func(whisper.EnvelopeEvent)) {
+	t.Lock()
+	defer t.Unlock()
+	t.onEvent = append(t.onEvent, f)
+}
+
+func (t *wireTap) loop() {
+	ticker := time.NewTicker(sentDelay)
+	defer ticker.Stop()
+	for {
+		select {
+		case ev := <-t.events:
+			t.handleEvent(ev)
+		case <-ticker.C:
+			t.matchSent()
+		case <-t.sub.Err():
+			return
+		}
+	}
+}
+
+func (t *wireTap) handleEvent(ev whisper.EnvelopeEvent) {
+	t.Lock()
+	handlers := t.onEvent
+	t.Unlock()
+	for _, f := range handlers {
+		f(ev)
+	}
+
+	switch ev.Event {
+	case whisper.EventEnvelopeReceived:
+		t.Lock()
+		t.seen[ev.Hash] = time.Now()
+		delete(t.available, ev.Hash)
+		t.Unlock()
+
+		// Rejected envelopes never make it to the pool, so they can't be
+		// attributed to a topic
+		envelope := t.shh.GetEnvelope(ev.Hash)
+		if envelope == nil {
+			return
+		}
+
+		t.Lock()
+		traffic := t.received[envelope.Topic]
+		traffic.envelopes++
+		traffic.bytes += envelopeSize(envelope)
+		t.received[envelope.Topic] = traffic
+		handlers := t.onReceived
+		t.Unlock()
+
+		for _, f := range handlers {
+			f(envelope)
+		}
+	case whisper.EventEnvelopeAvailable:
+		t.Lock()
+		defer t.Unlock()
+		if _, ok := t.seen[ev.Hash]; !ok {
+			t.available[ev.Hash] = time.Now()
+		}
+	}
+}
+
+// matchSent hands the envelopes that were never received from a peer to the
+// OnSent handlers.
+func (t *wireTap) matchSent() {
+	now := time.Now()
+
+	var sent []*whisper.Envelope
+	t.Lock()
+	for hash, at := range t.available {
+		if now.Sub(at) < sentDelay {
+			continue
+		}
+		delete(t.available, hash)
+		if envelope := t.shh.GetEnvelope(hash); envelope != nil {
+			sent = append(sent, envelope)
+		}
+	}
+	// Peers may send an envelope again, but not once it expired
+	for hash, at := range t.seen {
+		if now.Sub(at) > time.Minute {
+			delete(t.seen, hash)
+		}
+	}
+	handlers := t.onSent
+	t.Unlock()
+
+	for _, envelope := range sent {
+		for _, f := range handlers {
+			f(envelope)
+		}
+	}
+}
+
+// Received returns the traffic received from peers on topic so far.
+func (t *wireTap) Received(topic whisper.TopicType) topicTraffic {
+	t.Lock()
+	defer t.Unlock()
+	return t.received[topic]
+}
+
+// envelopeSize is the size of the envelope as Whisper accounts it.
+func envelopeSize(envelope *whisper.Envelope) int {
+	return whisper.EnvelopeHeaderLength + len(envelope.Data)
+}